@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -13,10 +12,8 @@ var accountEntropyCmd = &cobra.Command{
 	Use:   "entropy",
 	Short: "Update the account auto-postpone period",
 	Long:  `Update the account-level default auto-postpone period (in days). Requires admin role.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleAccountEntropy(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleAccountEntropy(cmd)
 	},
 }
 
@@ -27,19 +24,24 @@ func handleAccountEntropy(cmd *cobra.Command) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	days, _ := cmd.Flags().GetInt("auto-postpone-days")
+	if err := validateMin("auto-postpone-days", days, 0); err != nil {
+		return err
+	}
 	payload := fizzy.EntropyPayload{AutoPostponePeriodInDays: days}
 
-	account, err := a.Client.UpdateAccountEntropy(context.Background(), payload)
+	account, err := a.Client.UpdateAccountEntropy(cmd.Context(), payload)
 	if err != nil {
 		return fmt.Errorf("updating account entropy: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Account auto-postpone period set to %d days\n", account.AutoPostponePeriodInDays)
-	return nil
+	return printResult(cmd, account, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Account auto-postpone period set to %d days\n", account.AutoPostponePeriodInDays)
+		return nil
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -12,20 +11,18 @@ var accountJoincodeResetCmd = &cobra.Command{
 	Use:   "reset",
 	Short: "Reset the account join code",
 	Long:  `Generate a new join code, invalidating the existing one. Requires admin role.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleResetJoinCode(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleResetJoinCode(cmd)
 	},
 }
 
 func handleResetJoinCode(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.ResetAccountJoinCode(context.Background()); err != nil {
+	if err := a.Client.ResetAccountJoinCode(cmd.Context()); err != nil {
 		return fmt.Errorf("resetting join code: %w", err)
 	}
 
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,25 +12,25 @@ var accountJoincodeShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Show the account join code",
 	Long:  `Retrieve and display the account's join code`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowJoinCode(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowJoinCode(cmd)
 	},
 }
 
 func handleShowJoinCode(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	jc, err := a.Client.GetAccountJoinCode(context.Background())
+	jc, err := a.Client.GetAccountJoinCode(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching join code: %w", err)
 	}
 
-	return ui.DisplayJoinCode(cmd.OutOrStdout(), jc)
+	return printResult(cmd, jc, func() error {
+		return ui.DisplayJoinCode(cmd.OutOrStdout(), jc)
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -13,10 +12,8 @@ var accountJoincodeUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update the account join code",
 	Long:  `Update the join code's usage limit. Requires admin role.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateJoinCode(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateJoinCode(cmd)
 	},
 }
 
@@ -27,18 +24,29 @@ func handleUpdateJoinCode(cmd *cobra.Command) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	limit, _ := cmd.Flags().GetInt("usage-limit")
+	if err := validateMin("usage-limit", limit, 0); err != nil {
+		return err
+	}
 	payload := fizzy.UpdateJoinCodePayload{UsageLimit: limit}
 
-	if err := a.Client.UpdateAccountJoinCode(context.Background(), payload); err != nil {
+	if err := a.Client.UpdateAccountJoinCode(cmd.Context(), payload); err != nil {
 		return fmt.Errorf("updating join code: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Join code usage limit set to %d\n", limit)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		jc, err := a.Client.GetAccountJoinCode(cmd.Context())
+		if err != nil {
+			return nil, fmt.Errorf("fetching join code: %w", err)
+		}
+		return jc, nil
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Join code usage limit set to %d\n", limit)
+		return nil
+	})
 }
 
 func init() {
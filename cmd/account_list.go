@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,32 +12,32 @@ var accountListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all accounts",
 	Long:  `Retrieve and display all accounts from Fizzy`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListAccounts(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListAccounts(cmd)
 	},
 }
 
 func handleListAccounts(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	identity, err := a.Client.GetMyIdentity(context.Background())
+	identity, err := a.Client.GetMyIdentity(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching accounts: %w", err)
 	}
 
-	if len(identity.Accounts) == 0 {
-		fmt.Println("No accounts found")
-		return nil
-	}
-
-	return ui.DisplayAccounts(identity.Accounts)
+	return printList(cmd, identity.Accounts, func() error {
+		if len(identity.Accounts) == 0 {
+			fmt.Println("No accounts found")
+			return nil
+		}
+		return ui.DisplayAccounts(identity.Accounts)
+	})
 }
 
 func init() {
+	addListOutputFlags(accountListCmd)
 	accountCmd.AddCommand(accountListCmd)
 }
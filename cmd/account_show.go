@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,25 +12,25 @@ var accountShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Show current account details",
 	Long:  `Retrieve and display the current account's settings`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowAccount(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowAccount(cmd)
 	},
 }
 
 func handleShowAccount(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	account, err := a.Client.GetAccountSettings(context.Background())
+	account, err := a.Client.GetAccountSettings(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching account: %w", err)
 	}
 
-	return ui.DisplayAccount(cmd.OutOrStdout(), account)
+	return printResult(cmd, account, func() error {
+		return ui.DisplayAccount(cmd.OutOrStdout(), account)
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -14,47 +13,58 @@ var activityListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List recent activities",
 	Long:  `Retrieve and display the account's activity feed, newest first`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListActivities(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListActivities(cmd)
 	},
 }
 
 func handleListActivities(cmd *cobra.Command) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	filters := &fizzy.ActivityFilters{}
-	if creators, _ := cmd.Flags().GetStringSlice("creator"); len(creators) > 0 {
-		filters.CreatorIDs = creators
+	var err error
+	creators, _ := cmd.Flags().GetStringSlice("creator")
+	if filters.CreatorIDs, err = resolveUserIDs(cmd, a, creators); err != nil {
+		return err
 	}
-	if boards, _ := cmd.Flags().GetStringSlice("board"); len(boards) > 0 {
-		filters.BoardIDs = boards
+	boards, _ := cmd.Flags().GetStringSlice("board")
+	if filters.BoardIDs, err = a.ResolveBoardIDs(cmd.Context(), boards); err != nil {
+		return err
 	}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		filters.Limit = limit
 	}
 
-	activities, err := a.Client.GetActivities(context.Background(), filters)
+	activities, err := a.Client.GetActivities(cmd.Context(), filters)
 	if err != nil {
 		return fmt.Errorf("fetching activities: %w", err)
 	}
 
-	if len(activities) == 0 {
-		fmt.Fprintln(cmd.OutOrStdout(), "No activities found")
-		return nil
-	}
-
-	return ui.DisplayActivities(cmd.OutOrStdout(), activities)
+	return printList(cmd, activities, func() error {
+		if len(activities) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No activities found")
+			return nil
+		}
+		return ui.DisplayActivities(cmd.OutOrStdout(), activities)
+	})
 }
 
 func init() {
-	activityListCmd.Flags().StringSlice("creator", nil, "Filter by creator user ID (can be used multiple times)")
-	activityListCmd.Flags().StringSlice("board", nil, "Filter by board ID (can be used multiple times)")
+	activityListCmd.Flags().StringSlice("creator", nil, "Filter by creator user ID, name, email or \"me\" (can be used multiple times)")
+	activityListCmd.Flags().StringSlice("board", nil, "Filter by board ID or name (can be used multiple times)")
 	activityListCmd.Flags().IntP("limit", "l", 0, "Maximum number of activities to return (0 = no limit)")
+	activityListCmd.RegisterFlagCompletionFunc("creator", completeUsers)
+	activityListCmd.RegisterFlagCompletionFunc("board", completeBoards)
+
+	addListOutputFlags(activityListCmd)
 
 	activityCmd.AddCommand(activityListCmd)
 }
@@ -43,14 +43,19 @@ func TestActivityListCommandSuccess(t *testing.T) {
 
 func TestActivityListCommandFiltersAndLimit(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/activities" {
+			// The filters are looked up by name first; these are IDs.
+			json.NewEncoder(w).Encode([]any{})
+			return
+		}
 		q := r.URL.Query()
 		creators := q["creator_ids[]"]
 		if len(creators) != 1 || creators[0] != "user-1" {
 			t.Errorf("expected creator_ids[]=user-1, got %v", creators)
 		}
 		boards := q["board_ids[]"]
-		if len(boards) != 2 || boards[0] != "board-A" || boards[1] != "board-B" {
-			t.Errorf("expected board_ids[]=board-A,board-B, got %v", boards)
+		if len(boards) != 2 || boards[0] != "board-1" || boards[1] != "board-2" {
+			t.Errorf("expected board_ids[]=board-1,board-2, got %v", boards)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -65,7 +70,7 @@ func TestActivityListCommandFiltersAndLimit(t *testing.T) {
 
 	cmd := activityListCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
-	cmd.ParseFlags([]string{"--creator", "user-1", "--board", "board-A,board-B", "--limit", "5"})
+	cmd.ParseFlags([]string{"--creator", "user-1", "--board", "board-1,board-2", "--limit", "5"})
 
 	if err := handleListActivities(cmd); err != nil {
 		t.Fatalf("handleListActivities failed: %v", err)
@@ -103,7 +108,7 @@ func TestActivityListCommandAPIError(t *testing.T) {
 	client := testutil.NewTestClient(server.URL, "", "", "test-token")
 	testApp := &app.App{Client: client}
 
-	cmd := activityListCmd
+	cmd := &cobra.Command{}
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
 	err := handleListActivities(cmd)
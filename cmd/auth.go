@@ -0,0 +1,13 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Inspect authentication",
+	Long:  `Inspect the access token in use and what it gives access to`,
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the access token in use and what it can access",
+	Long: `Check the access token in use against the API and show who it belongs to,
+the accounts it can access, the selected account and board, and whether it
+is a read or write token.
+
+The permission is known for tokens created by 'fizzy login --email' and
+'fizzy token rotate'. Otherwise it's worked out from your token list when all
+your tokens have the same permission, and reported as unknown when they don't.
+Once known to be read-only, commands that make changes fail without
+contacting the API.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleAuthStatus(cmd)
+	},
+}
+
+func handleAuthStatus(cmd *cobra.Command) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil {
+		return errNoClient
+	}
+	if a.AuthErr != nil {
+		return fmt.Errorf("%w: %v", errNoClient, a.AuthErr)
+	}
+	if a.Token == "" {
+		return fmt.Errorf("%w: not logged in, run 'fizzy login'", errNoClient)
+	}
+
+	client := a.Client
+	if client == nil {
+		var err error
+		if client, err = identityClient(a, a.Token); err != nil {
+			return err
+		}
+	}
+
+	identity, err := client.GetMyIdentity(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("checking access token: %w", err)
+	}
+
+	status := &ui.AuthStatus{
+		Token:           app.RedactToken(a.Token),
+		Source:          a.Credentials.Location(),
+		Profile:         a.Config.Profile(),
+		Permission:      a.Permission,
+		Accounts:        identity.Accounts,
+		SelectedAccount: a.SelectedAccount(),
+		SelectedBoard:   a.SelectedBoard(),
+	}
+	if a.TokenFromEnv {
+		status.Source = "FIZZY_ACCESS_TOKEN"
+	}
+	for _, account := range identity.Accounts {
+		if account.Slug == a.SelectedAccount() || status.User.ID == "" {
+			status.User = account.User
+		}
+	}
+
+	if a.Client != nil {
+		if status.Permission == "" {
+			if status.Permission, err = detectPermission(cmd, a); err != nil {
+				return err
+			}
+		}
+		if a.SelectedBoard() != "" {
+			// The name is a nicety; a board that's gone still shows its ID.
+			if board, err := a.Client.GetBoard(cmd.Context(), a.SelectedBoard()); err == nil {
+				status.BoardName = board.Name
+			}
+		}
+	}
+	if status.Permission == "" {
+		status.Permission = "unknown"
+	}
+
+	return printResult(cmd, status, func() error {
+		return ui.DisplayAuthStatus(cmd.OutOrStdout(), status)
+	})
+}
+
+// detectPermission works out the permission of the token in use from the
+// token list: when all tokens share a permission, so does this one. A
+// stored token's permission is remembered once known.
+func detectPermission(cmd *cobra.Command, a *app.App) (string, error) {
+	tokens, err := app.ListAccessTokens(cmd.Context(), a.Client)
+	if err != nil || len(tokens) == 0 {
+		return "", nil
+	}
+	permission := tokens[0].Permission
+	for _, token := range tokens[1:] {
+		if token.Permission != permission {
+			return "", nil
+		}
+	}
+
+	if !a.TokenFromEnv {
+		a.Config.SetTokenPermission(permission)
+		if err := a.Config.Save(); err != nil {
+			return "", fmt.Errorf("saving config: %w", err)
+		}
+	}
+	return permission, nil
+}
+
+func init() {
+	authCmd.AddCommand(authStatusCmd)
+}
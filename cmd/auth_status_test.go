@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newAuthStatusCmd(output string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("output", "o", output, "")
+	return cmd
+}
+
+func TestAuthStatusCommand(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/my/identity":
+			json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{Accounts: []fizzy.Account{
+				{Name: "Other", Slug: "/other", User: fizzy.User{ID: "user-9", Name: "Other Me"}},
+				{Name: "Test Account", Slug: "/test-account", User: fizzy.User{ID: "user-1", Name: "Test User", Email: "test@example.com"}},
+			}})
+		case "/my/access_tokens":
+			json.NewEncoder(w).Encode([]app.AccessToken{
+				{ID: "tok-1", Permission: "read"},
+				{ID: "tok-2", Permission: "read"},
+			})
+		case "/test-account/boards/board-123":
+			json.NewEncoder(w).Encode(fizzy.Board{ID: "board-123", Name: "Roadmap"})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{SelectedAccount: "/test-account", SelectedBoard: "board-123"}
+	testApp := &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "test-token-value"),
+		Config:      cfg,
+		Token:       "test-token-value",
+		Credentials: config.NewCredentialStore(cfg, "app.fizzy.do"),
+	}
+
+	cmd := newAuthStatusCmd("json")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleAuthStatus(cmd); err != nil {
+		t.Fatalf("handleAuthStatus failed: %v", err)
+	}
+
+	var status ui.AuthStatus
+	if err := json.Unmarshal(out.Bytes(), &status); err != nil {
+		t.Fatalf("decoding output: %v\n%s", err, out.String())
+	}
+	if status.Permission != "read" {
+		t.Errorf("expected permission read, got %q", status.Permission)
+	}
+	if status.User.ID != "user-1" {
+		t.Errorf("expected the selected account's user, got %+v", status.User)
+	}
+	if status.BoardName != "Roadmap" {
+		t.Errorf("expected board name Roadmap, got %q", status.BoardName)
+	}
+	if len(status.Accounts) != 2 {
+		t.Errorf("expected 2 accounts, got %d", len(status.Accounts))
+	}
+	if strings.Contains(out.String(), "test-token-value") {
+		t.Errorf("expected token to be redacted, got:\n%s", out.String())
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if got := saved.TokenPermission(); got != "read" {
+		t.Errorf("expected detected permission to be saved, got %q", got)
+	}
+}
+
+func TestAuthStatusCommandMixedPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/my/identity":
+			json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{Accounts: []fizzy.Account{{Name: "Test Account", Slug: "/test-account"}}})
+		case "/my/access_tokens":
+			json.NewEncoder(w).Encode([]app.AccessToken{{ID: "tok-1", Permission: "read"}, {ID: "tok-2", Permission: "write"}})
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{SelectedAccount: "/test-account"}
+	testApp := &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Config:      cfg,
+		Token:       "test-token",
+		Credentials: config.NewCredentialStore(cfg, "app.fizzy.do"),
+	}
+
+	cmd := newAuthStatusCmd("text")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleAuthStatus(cmd); err != nil {
+		t.Fatalf("handleAuthStatus failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "Permission: unknown") {
+		t.Errorf("expected unknown permission, got:\n%s", out.String())
+	}
+}
+
+func TestAuthStatusCommandInvalidToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{SelectedAccount: "/test-account"}
+	testApp := &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "revoked"),
+		Config:      cfg,
+		Token:       "revoked",
+		Credentials: config.NewCredentialStore(cfg, "app.fizzy.do"),
+	}
+
+	cmd := newAuthStatusCmd("text")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleAuthStatus(cmd)
+	if code := exitCode(err); code != exitAuth {
+		t.Errorf("expected exit code %d, got %d (%v)", exitAuth, code, err)
+	}
+}
+
+func TestAuthStatusCommandNotLoggedIn(t *testing.T) {
+	testApp := &app.App{Config: &config.Config{}}
+
+	cmd := newAuthStatusCmd("text")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleAuthStatus(cmd)
+	if code := exitCode(err); code != exitAuth {
+		t.Errorf("expected exit code %d, got %d (%v)", exitAuth, code, err)
+	}
+}
@@ -2,7 +2,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -17,31 +16,34 @@ var boardCmd = &cobra.Command{
 
 Use subcommands to list, create, or manage boards:
   fizzy board list      List all boards
-  fizzy board create    Create a new board`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowBoard(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+  fizzy board create    Create a new board
+  fizzy board view      Show the board as an interactive kanban board
+
+Boards can be given by ID, name, or the start of a name.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowBoard(cmd)
 	},
 }
 
 func handleShowBoard(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if a.Config.SelectedBoard == "" {
+	if a.SelectedBoard() == "" {
 		return fmt.Errorf("no board selected")
 	}
 
-	board, err := a.Client.GetBoard(context.Background(), a.Config.SelectedBoard)
+	board, err := a.Client.GetBoard(cmd.Context(), a.SelectedBoard())
 	if err != nil {
 		return fmt.Errorf("fetching board: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "%s (%s)\n", board.Name, ui.DisplayID(board.ID))
-	return nil
+	return printResult(cmd, board, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s (%s)\n", board.Name, ui.DisplayID(board.ID))
+		return nil
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -11,37 +10,48 @@ import (
 )
 
 var boardAccessListCmd = &cobra.Command{
-	Use:   "list <board_id>",
+	Use:   "list <board>",
 	Short: "List user access for a board",
 	Long:  `Retrieve and display the access list for a board`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListBoardAccesses(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListBoardAccesses(cmd, args[0])
 	},
 }
 
 func handleListBoardAccesses(cmd *cobra.Command, boardID string) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	opts := &fizzy.ListOptions{}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		opts.Limit = limit
 	}
 
-	accesses, err := a.Client.GetBoardAccesses(context.Background(), boardID, opts)
+	var accesses *fizzy.BoardAccesses
+	_, err := forBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		accesses, err = a.Client.GetBoardAccesses(cmd.Context(), id, opts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching board accesses: %w", err)
 	}
 
-	return ui.DisplayBoardAccesses(cmd.OutOrStdout(), accesses)
+	return printListOf(cmd, accesses, accesses.Users, func() error {
+		return ui.DisplayBoardAccesses(cmd.OutOrStdout(), accesses)
+	})
 }
 
 func init() {
 	boardAccessListCmd.Flags().IntP("limit", "l", 0, "Maximum number of users to return (0 = no limit)")
+	addListOutputFlags(boardAccessListCmd)
+	boardAccessListCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardAccessCmd.AddCommand(boardAccessListCmd)
 }
@@ -13,23 +13,24 @@ var boardCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new board",
 	Long:  `Create a new board in Fizzy`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateBoard(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateBoard(cmd)
 	},
 }
 
 func handleCreateBoard(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	// Read flag values directly from command
 	name, _ := cmd.Flags().GetString("name")
 	allAccess, _ := cmd.Flags().GetBool("all-access")
 	autoPostponePeriod, _ := cmd.Flags().GetInt("auto-postpone-period")
+	if err := validateMin("auto-postpone-period", autoPostponePeriod, 0); err != nil {
+		return err
+	}
 	publicDescription, _ := cmd.Flags().GetString("description")
 
 	payload := fizzy.CreateBoardPayload{
@@ -39,13 +40,17 @@ func handleCreateBoard(cmd *cobra.Command) error {
 		PublicDescription:        publicDescription,
 	}
 
-	err := a.Client.CreateBoard(context.Background(), payload)
+	err := a.Client.CreateBoard(cmd.Context(), payload)
 	if err != nil {
 		return fmt.Errorf("creating board: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' created successfully\n", name)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		return findCreatedBoard(cmd.Context(), a, name)
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' created successfully\n", name)
+		return nil
+	})
 }
 
 func init() {
@@ -57,3 +62,25 @@ func init() {
 
 	boardCmd.AddCommand(boardCreateCmd)
 }
+
+// findCreatedBoard looks up the board that was just created, since the API
+// only answers with a Location header. Board names are not unique, so the
+// most recently created match wins.
+func findCreatedBoard(ctx context.Context, a *app.App, name string) (*fizzy.Board, error) {
+	boards, err := a.Client.GetBoards(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching created board: %w", err)
+	}
+
+	var created *fizzy.Board
+	for i := range boards {
+		if boards[i].Name == name && (created == nil || boards[i].CreatedAt > created.CreatedAt) {
+			created = &boards[i]
+		}
+	}
+
+	if created == nil {
+		return nil, fmt.Errorf("created board '%s' not found", name)
+	}
+	return created, nil
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -9,24 +8,24 @@ import (
 )
 
 var boardDeleteCmd = &cobra.Command{
-	Use:   "delete <board_id>",
+	Use:   "delete <board>",
 	Short: "Delete a board",
 	Long:  `Delete a board. Only board administrators can delete boards.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteBoard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteBoard(cmd, args[0])
 	},
 }
 
 func handleDeleteBoard(cmd *cobra.Command, boardID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err := a.Client.DeleteBoard(context.Background(), boardID)
+	boardID, err := forBoard(cmd, a, boardID, app.MatchExact, func(id string) error {
+		return a.Client.DeleteBoard(cmd.Context(), id)
+	})
 	if err != nil {
 		return fmt.Errorf("deleting board: %w", err)
 	}
@@ -36,5 +35,6 @@ func handleDeleteBoard(cmd *cobra.Command, boardID string) error {
 }
 
 func init() {
+	boardDeleteCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardCmd.AddCommand(boardDeleteCmd)
 }
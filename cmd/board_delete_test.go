@@ -2,12 +2,16 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestBoardDeleteCommandSuccess(t *testing.T) {
@@ -52,7 +56,7 @@ func TestBoardDeleteCommandNotFound(t *testing.T) {
 	cmd := boardDeleteCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
-	err := handleDeleteBoard(cmd, "nonexistent-board")
+	err := handleDeleteBoard(cmd, "board-404")
 	if err == nil {
 		t.Errorf("expected error for board not found")
 	}
@@ -119,3 +123,42 @@ func TestBoardDeleteCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+func TestBoardDeleteCommandByName(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/test-account/boards":
+			json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-1", Name: "Roadmap"}})
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	for _, name := range []string{"road", "roadmap"} {
+		err := handleDeleteBoard(cmd, name)
+		want := "deleting board: board '" + name + "' not found, did you mean Roadmap (board-1)?"
+		if err == nil || err.Error() != want {
+			t.Errorf("expected %q, got %v", want, err)
+		}
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("expected nothing deleted by a partial name, got %v", deleted)
+	}
+
+	if err := handleDeleteBoard(cmd, "Roadmap"); err != nil {
+		t.Fatalf("handleDeleteBoard failed: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "/test-account/boards/board-1" {
+		t.Errorf("expected board-1 deleted, got %v", deleted)
+	}
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -10,14 +9,12 @@ import (
 )
 
 var boardEntropyCmd = &cobra.Command{
-	Use:   "entropy <board_id>",
+	Use:   "entropy <board>",
 	Short: "Update a board's auto-postpone period",
 	Long:  `Update the auto-postpone period (in days) for a specific board. Requires board admin permission.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleBoardEntropy(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleBoardEntropy(cmd, args[0])
 	},
 }
 
@@ -28,22 +25,32 @@ func handleBoardEntropy(cmd *cobra.Command, boardID string) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	days, _ := cmd.Flags().GetInt("auto-postpone-days")
+	if err := validateMin("auto-postpone-days", days, 0); err != nil {
+		return err
+	}
 	payload := fizzy.EntropyPayload{AutoPostponePeriodInDays: days}
 
-	board, err := a.Client.UpdateBoardEntropy(context.Background(), boardID, payload)
+	var board *fizzy.Board
+	_, err := forBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		board, err = a.Client.UpdateBoardEntropy(cmd.Context(), id, payload)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("updating board entropy: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' auto-postpone period set to %d days\n", board.Name, board.AutoPostponePeriodInDays)
-	return nil
+	return printResult(cmd, board, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' auto-postpone period set to %d days\n", board.Name, board.AutoPostponePeriodInDays)
+		return nil
+	})
 }
 
 func init() {
 	boardEntropyCmd.Flags().Int("auto-postpone-days", 0, "Auto-postpone period in days (required)")
+	boardEntropyCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardCmd.AddCommand(boardEntropyCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -14,38 +13,43 @@ var boardListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all boards",
 	Long:  `Retrieve and display all boards from Fizzy`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListBoards(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListBoards(cmd)
 	},
 }
 
 func handleListBoards(cmd *cobra.Command) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	opts := &fizzy.ListOptions{}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		opts.Limit = limit
 	}
 
-	boards, err := a.Client.GetBoards(context.Background(), opts)
+	boards, err := a.Client.GetBoards(cmd.Context(), opts)
 	if err != nil {
 		return fmt.Errorf("fetching boards: %w", err)
 	}
 
-	if len(boards) == 0 {
-		fmt.Println("No boards found")
-		return nil
-	}
-
-	return ui.DisplayBoards(boards)
+	return printList(cmd, boards, func() error {
+		if len(boards) == 0 {
+			fmt.Println("No boards found")
+			return nil
+		}
+		return ui.DisplayBoards(boards)
+	})
 }
 
 func init() {
 	boardListCmd.Flags().IntP("limit", "l", 0, "Maximum number of boards to return (0 = no limit)")
+	addListOutputFlags(boardListCmd)
 	boardCmd.AddCommand(boardListCmd)
 }
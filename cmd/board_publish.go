@@ -1,40 +1,45 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
 )
 
 var boardPublishCmd = &cobra.Command{
-	Use:   "publish <board_id>",
+	Use:   "publish <board>",
 	Short: "Publish a board",
 	Long:  `Make a board publicly accessible`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handlePublishBoard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handlePublishBoard(cmd, args[0])
 	},
 }
 
 func handlePublishBoard(cmd *cobra.Command, boardID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	_, err := a.Client.PublishBoard(context.Background(), boardID)
+	var board *fizzy.Board
+	boardID, err := forBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		board, err = a.Client.PublishBoard(cmd.Context(), id)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("publishing board: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' published successfully\n", boardID)
-	return nil
+	return printResult(cmd, board, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' published successfully\n", boardID)
+		return nil
+	})
 }
 
 func init() {
+	boardPublishCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardCmd.AddCommand(boardPublishCmd)
 }
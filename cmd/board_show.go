@@ -1,40 +1,45 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var boardShowCmd = &cobra.Command{
-	Use:   "show <board_id>",
+	Use:   "show <board>",
 	Short: "Show board details",
 	Long:  `Retrieve and display detailed information about a specific board`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowBoardDetails(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowBoardDetails(cmd, args[0])
 	},
 }
 
 func handleShowBoardDetails(cmd *cobra.Command, boardID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	board, err := a.Client.GetBoard(context.Background(), boardID)
+	var board *fizzy.Board
+	_, err := forBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		board, err = a.Client.GetBoard(cmd.Context(), id)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching board: %w", err)
 	}
 
-	return ui.DisplayBoard(cmd.OutOrStdout(), board)
+	return printResult(cmd, board, func() error {
+		return ui.DisplayBoard(cmd.OutOrStdout(), board)
+	})
 }
 
 func init() {
+	boardShowCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardCmd.AddCommand(boardShowCmd)
 }
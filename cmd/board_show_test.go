@@ -1,15 +1,18 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestBoardShowCommandSuccess(t *testing.T) {
@@ -66,7 +69,7 @@ func TestBoardShowCommandNotFound(t *testing.T) {
 	cmd := boardShowCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
-	err := handleShowBoardDetails(cmd, "nonexistent-board")
+	err := handleShowBoardDetails(cmd, "board-404")
 	if err == nil {
 		t.Errorf("expected error for board not found")
 	}
@@ -111,3 +114,86 @@ func TestBoardShowCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+// boardsServer serves the boards list and the boards in it by ID, and 404s
+// anything else.
+func boardsServer(boards []fizzy.Board) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/test-account/boards" {
+			json.NewEncoder(w).Encode(boards)
+			return
+		}
+		for _, b := range boards {
+			if r.URL.Path == "/test-account/boards/"+b.ID {
+				json.NewEncoder(w).Encode(b)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Board not found"))
+	}))
+}
+
+func TestBoardShowCommandByName(t *testing.T) {
+	server := boardsServer([]fizzy.Board{
+		{ID: "board-1", Name: "Project Alpha"},
+		{ID: "board-2", Name: "Marketing"},
+	})
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	for _, query := range []string{"Project Alpha", "project alpha", "proj"} {
+		cmd := &cobra.Command{}
+		cmd.SetContext(testApp.ToContext(context.Background()))
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+
+		if err := handleShowBoardDetails(cmd, query); err != nil {
+			t.Fatalf("handleShowBoardDetails(%q) failed: %v", query, err)
+		}
+		if !strings.Contains(out.String(), "board-1") {
+			t.Errorf("expected %q to show board-1, got %q", query, out.String())
+		}
+	}
+}
+
+func TestBoardShowCommandAmbiguousName(t *testing.T) {
+	server := boardsServer([]fizzy.Board{
+		{ID: "board-1", Name: "Project Alpha"},
+		{ID: "board-2", Name: "Project Beta"},
+	})
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleShowBoardDetails(cmd, "project")
+	if code := exitCode(err); code != exitUsage {
+		t.Errorf("expected usage error, got %d: %v", code, err)
+	}
+	want := "fetching board: board 'project' matches more than one board, did you mean Project Alpha (board-1) or Project Beta (board-2)?"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
+
+func TestBoardShowCommandNameSuggestions(t *testing.T) {
+	server := boardsServer([]fizzy.Board{
+		{ID: "board-1", Name: "Marketing"},
+		{ID: "board-2", Name: "Engineering"},
+	})
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleShowBoardDetails(cmd, "Marketnig")
+	if code := exitCode(err); code != exitNotFound {
+		t.Errorf("expected not found, got %d: %v", code, err)
+	}
+	if err == nil || !strings.HasSuffix(err.Error(), "board 'Marketnig' not found, did you mean Marketing (board-1)?") {
+		t.Errorf("expected a suggestion, got %v", err)
+	}
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -9,24 +8,24 @@ import (
 )
 
 var boardUnpublishCmd = &cobra.Command{
-	Use:   "unpublish <board_id>",
+	Use:   "unpublish <board>",
 	Short: "Unpublish a board",
 	Long:  `Remove public access from a board`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUnpublishBoard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUnpublishBoard(cmd, args[0])
 	},
 }
 
 func handleUnpublishBoard(cmd *cobra.Command, boardID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err := a.Client.UnpublishBoard(context.Background(), boardID)
+	boardID, err := forBoard(cmd, a, boardID, app.MatchExact, func(id string) error {
+		return a.Client.UnpublishBoard(cmd.Context(), id)
+	})
 	if err != nil {
 		return fmt.Errorf("unpublishing board: %w", err)
 	}
@@ -36,5 +35,6 @@ func handleUnpublishBoard(cmd *cobra.Command, boardID string) error {
 }
 
 func init() {
+	boardUnpublishCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardCmd.AddCommand(boardUnpublishCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -10,17 +9,24 @@ import (
 )
 
 var boardUpdateCmd = &cobra.Command{
-	Use:   "update <board_id>",
+	Use:   "update <board>",
 	Short: "Update a board",
 	Long:  `Update board settings such as name, access permissions, and auto-postpone period`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateBoard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateBoard(cmd, args[0])
 	},
 }
 
+// boardUpdateFlags maps the fields of a board update to the flags setting
+// them.
+var boardUpdateFlags = map[string]string{
+	"name":                         "name",
+	"all_access":                   "all-access",
+	"auto_postpone_period_in_days": "auto-postpone-period",
+	"public_description":           "description",
+}
+
 func handleUpdateBoard(cmd *cobra.Command, boardID string) error {
 	// Check that at least one flag was explicitly set
 	if !cmd.Flags().Changed("name") && !cmd.Flags().Changed("all-access") &&
@@ -30,7 +36,7 @@ func handleUpdateBoard(cmd *cobra.Command, boardID string) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	// Build payload only with flags that were explicitly set
@@ -46,6 +52,9 @@ func handleUpdateBoard(cmd *cobra.Command, boardID string) error {
 	}
 	if cmd.Flags().Changed("auto-postpone-period") {
 		autoPostponePeriod, _ := cmd.Flags().GetInt("auto-postpone-period")
+		if err := validateMin("auto-postpone-period", autoPostponePeriod, 0); err != nil {
+			return err
+		}
 		payload.AutoPostponePeriodInDays = &autoPostponePeriod
 	}
 	if cmd.Flags().Changed("description") {
@@ -53,13 +62,23 @@ func handleUpdateBoard(cmd *cobra.Command, boardID string) error {
 		payload.PublicDescription = publicDescription
 	}
 
-	err := a.Client.UpdateBoard(context.Background(), boardID, payload)
+	boardID, err := forBoard(cmd, a, boardID, app.MatchPrefix, func(id string) error {
+		return a.Client.UpdateBoard(cmd.Context(), id, payload)
+	})
 	if err != nil {
-		return fmt.Errorf("updating board: %w", err)
+		return wrapAPIError("updating board", err, boardUpdateFlags)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' updated successfully\n", boardID)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		board, err := a.Client.GetBoard(cmd.Context(), boardID)
+		if err != nil {
+			return nil, fmt.Errorf("fetching board: %w", err)
+		}
+		return board, nil
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Board '%s' updated successfully\n", boardID)
+		return nil
+	})
 }
 
 func init() {
@@ -68,5 +87,6 @@ func init() {
 	boardUpdateCmd.Flags().Int("auto-postpone-period", 0, "Auto postpone period in days")
 	boardUpdateCmd.Flags().String("description", "", "Public description of the board")
 
+	boardUpdateCmd.ValidArgsFunction = completeArgs(completeBoards)
 	boardCmd.AddCommand(boardUpdateCmd)
 }
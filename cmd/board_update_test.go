@@ -184,7 +184,7 @@ func TestBoardUpdateCommandNotFound(t *testing.T) {
 	cmd.SetContext(testApp.ToContext(context.Background()))
 	cmd.ParseFlags([]string{"--name", "Updated Board"})
 
-	err := handleUpdateBoard(cmd, "nonexistent-board")
+	err := handleUpdateBoard(cmd, "board-404")
 	if err == nil {
 		t.Errorf("expected error for board not found")
 	}
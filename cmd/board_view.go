@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/colors"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var boardViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show the selected board as an interactive kanban board",
+	Long: `Show the selected board as a kanban board on the terminal: its columns,
+between the Maybe? lane of cards awaiting triage and the Not Now and Done
+lanes. Use --board to view another board.
+
+Keys:
+  ←/→ or h/l        Select a lane
+  ↑/↓ or k/j        Select a card
+  H/L               Move the card to the previous or next lane
+  c                 Close the card
+  p                 Postpone the card (Not Now)
+  a                 Assign users to the card, or unassign them
+  t                 Toggle a tag on the card
+  Enter             Show the card's details
+  r                 Refresh now
+  q                 Quit
+
+With a read-only access token, the keys that change cards are turned off.
+The board refreshes in the background every --refresh.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleViewBoard(cmd)
+	},
+}
+
+func handleViewBoard(cmd *cobra.Command) error {
+	refresh, _ := cmd.Flags().GetDuration("refresh")
+	if refresh < 0 {
+		return &usageError{fmt.Errorf("--refresh: must not be negative, got %s", refresh)}
+	}
+	if !ui.IsTerminal() {
+		return &usageError{fmt.Errorf("board view needs a terminal")}
+	}
+
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return errNoClient
+	}
+
+	if a.SelectedBoard() == "" {
+		return fmt.Errorf("no board selected")
+	}
+
+	board, err := a.Client.GetBoard(cmd.Context(), a.SelectedBoard())
+	if err != nil {
+		return fmt.Errorf("fetching board: %w", err)
+	}
+
+	// A read-only token can browse the board, but not change its cards.
+	err = ui.ViewBoard(cmd.Context(), board.Name, &boardSource{a: a, boardID: board.ID}, refresh, a.Permission == "read")
+	if errors.Is(err, ui.ErrNotTerminal) {
+		return &usageError{fmt.Errorf("board view needs a terminal")}
+	}
+	return err
+}
+
+// doneLaneLimit caps how many cards the Done lane shows, the most recently
+// closed first.
+const doneLaneLimit = 50
+
+// boardSource lays a board out for ui.ViewBoard and changes its cards.
+type boardSource struct {
+	a       *app.App
+	boardID string
+}
+
+func (s *boardSource) Lanes(ctx context.Context) ([]ui.Lane, error) {
+	columns, err := s.a.Client.GetColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching columns: %w", err)
+	}
+
+	board := []string{s.boardID}
+	maybe, err := s.a.Client.GetCards(ctx, &fizzy.CardFilters{BoardIDs: board, IndexedBy: "maybe"})
+	if err != nil {
+		return nil, fmt.Errorf("fetching cards awaiting triage: %w", err)
+	}
+	notNow, err := s.a.Client.GetCards(ctx, &fizzy.CardFilters{BoardIDs: board, IndexedBy: "not_now"})
+	if err != nil {
+		return nil, fmt.Errorf("fetching postponed cards: %w", err)
+	}
+	done, err := s.a.Client.GetCards(ctx, &fizzy.CardFilters{BoardIDs: board, IndexedBy: "closed", Limit: doneLaneLimit})
+	if err != nil {
+		return nil, fmt.Errorf("fetching closed cards: %w", err)
+	}
+
+	lanes := []ui.Lane{{Kind: ui.LaneMaybe, Name: "Maybe?", Color: colors.Blue.TermColor, Cards: maybe}}
+	for i, column := range columns {
+		cards, err := s.a.Client.GetColumnCards(ctx, column.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("fetching cards in %s: %w", column.Name, err)
+		}
+		lanes = append(lanes, ui.Lane{
+			Kind:   ui.LaneColumn,
+			Name:   column.Name,
+			Column: &columns[i],
+			Color:  ui.ColumnColor(column),
+			Cards:  cards,
+		})
+	}
+	return append(lanes,
+		ui.Lane{Kind: ui.LaneNotNow, Name: "Not Now", Color: colors.Gray.TermColor, Cards: notNow},
+		ui.Lane{Kind: ui.LaneDone, Name: "Done", Color: colors.Lime.TermColor, Cards: done},
+	), nil
+}
+
+func (s *boardSource) Card(ctx context.Context, number int) (*fizzy.Card, error) {
+	card, err := s.a.Client.GetCard(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("fetching card: %w", err)
+	}
+	return card, nil
+}
+
+// Move moves card to the lane to, reopening it first when it's closed.
+func (s *boardSource) Move(ctx context.Context, card fizzy.Card, to ui.Lane) error {
+	if card.Closed && to.Kind != ui.LaneDone {
+		if err := s.a.Client.ReopenCard(ctx, card.Number); err != nil {
+			return fmt.Errorf("reopening card: %w", err)
+		}
+	}
+
+	switch to.Kind {
+	case ui.LaneMaybe:
+		if err := s.a.Client.UnTriageCard(ctx, card.Number); err != nil {
+			return fmt.Errorf("sending card back to triage: %w", err)
+		}
+	case ui.LaneColumn:
+		if err := s.a.Client.TriageCard(ctx, card.Number, to.Column.ID); err != nil {
+			return fmt.Errorf("triaging card: %w", err)
+		}
+	case ui.LaneNotNow:
+		if err := s.a.Client.PostponeCard(ctx, card.Number); err != nil {
+			return fmt.Errorf("postponing card: %w", err)
+		}
+	case ui.LaneDone:
+		if err := s.a.Client.CloseCard(ctx, card.Number); err != nil {
+			return fmt.Errorf("closing card: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *boardSource) Users(ctx context.Context) ([]fizzy.User, error) {
+	users, err := s.a.Client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching users: %w", err)
+	}
+	return users, nil
+}
+
+func (s *boardSource) Assign(ctx context.Context, card fizzy.Card, userID string) error {
+	if err := s.a.Client.AssignCard(ctx, card.Number, userID); err != nil {
+		return fmt.Errorf("assigning card: %w", err)
+	}
+	return nil
+}
+
+func (s *boardSource) Tag(ctx context.Context, card fizzy.Card, title string) error {
+	if err := s.a.Client.TagCard(ctx, card.Number, title); err != nil {
+		return fmt.Errorf("toggling tag on card: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	boardViewCmd.Flags().Duration("refresh", 30*time.Second, "How often to refresh the board in the background, e.g. 10s or 2m (0 disables)")
+
+	boardCmd.AddCommand(boardViewCmd)
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func TestBoardSourceLanes(t *testing.T) {
+	doing := &fizzy.Column{ID: "col-2", Name: "Doing"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test-account/boards/board-1/columns":
+			json.NewEncoder(w).Encode([]fizzy.Column{
+				{ID: "col-1", Name: "Backlog", Color: fizzy.ColorObject{Name: "Pink"}},
+				*doing,
+			})
+		case "/test-account/boards/board-1/columns/col-1/cards":
+			json.NewEncoder(w).Encode([]fizzy.Card{{Number: 3, Title: "Plan"}})
+		case "/test-account/boards/board-1/columns/col-2/cards":
+			json.NewEncoder(w).Encode([]fizzy.Card{{Number: 4, Title: "Build", Column: doing}})
+		case "/test-account/cards":
+			if got := r.URL.Query()["board_ids[]"]; !slices.Equal(got, []string{"board-1"}) {
+				t.Errorf("expected cards of board-1, got board_ids[]=%v", got)
+			}
+			switch r.URL.Query().Get("indexed_by") {
+			case "maybe":
+				json.NewEncoder(w).Encode([]fizzy.Card{{Number: 1, Title: "Idea"}})
+			case "not_now":
+				json.NewEncoder(w).Encode([]fizzy.Card{{Number: 5, Title: "Later", Postponed: true}})
+			case "closed":
+				json.NewEncoder(w).Encode([]fizzy.Card{{Number: 6, Title: "Shipped", Closed: true}})
+			default:
+				t.Errorf("expected each lane's cards by index, got indexed_by=%q", r.URL.Query().Get("indexed_by"))
+			}
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token")}
+	source := &boardSource{a: testApp, boardID: "board-1"}
+
+	lanes, err := source.Lanes(context.Background())
+	if err != nil {
+		t.Fatalf("Lanes failed: %v", err)
+	}
+
+	var got []string
+	for _, lane := range lanes {
+		var titles []string
+		for _, card := range lane.Cards {
+			titles = append(titles, card.Title)
+		}
+		got = append(got, lane.Name+": "+strings.Join(titles, ","))
+	}
+	want := []string{"Maybe?: Idea", "Backlog: Plan", "Doing: Build", "Not Now: Later", "Done: Shipped"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected lanes %q, got %q", want, got)
+	}
+	if lanes[1].Kind != ui.LaneColumn || lanes[1].Column.ID != "col-1" || lanes[1].Color != "205" {
+		t.Errorf("expected the Backlog lane to be the pink col-1 column, got %+v", lanes[1])
+	}
+	if lanes[0].Kind != ui.LaneMaybe || lanes[3].Kind != ui.LaneNotNow || lanes[4].Kind != ui.LaneDone {
+		t.Errorf("expected the Maybe?, Not Now and Done lanes around the columns")
+	}
+}
+
+func TestBoardSourceMove(t *testing.T) {
+	column := ui.Lane{Kind: ui.LaneColumn, Name: "Doing", Column: &fizzy.Column{ID: "col-2"}}
+	tests := []struct {
+		name string
+		card fizzy.Card
+		to   ui.Lane
+		want []string
+	}{
+		{"to column", fizzy.Card{Number: 1}, column, []string{"POST /cards/1/triage col-2"}},
+		{"to maybe", fizzy.Card{Number: 1}, ui.Lane{Kind: ui.LaneMaybe}, []string{"DELETE /cards/1/triage"}},
+		{"to not now", fizzy.Card{Number: 1}, ui.Lane{Kind: ui.LaneNotNow}, []string{"POST /cards/1/not_now"}},
+		{"to done", fizzy.Card{Number: 1}, ui.Lane{Kind: ui.LaneDone}, []string{"POST /cards/1/closure"}},
+		{"closed to column", fizzy.Card{Number: 1, Closed: true}, column, []string{"DELETE /cards/1/closure", "POST /cards/1/triage col-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				request := r.Method + " " + strings.TrimPrefix(r.URL.Path, "/test-account")
+				var payload map[string]string
+				if json.NewDecoder(r.Body).Decode(&payload) == nil {
+					request += " " + payload["column_id"]
+				}
+				got = append(got, request)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token")}
+			source := &boardSource{a: testApp, boardID: "board-1"}
+
+			if err := source.Move(context.Background(), tt.card, tt.to); err != nil {
+				t.Fatalf("Move failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected requests %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBoardViewCommandNeedsTerminal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected no requests without a terminal, got %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-1"},
+	}
+	cmd := &cobra.Command{}
+	cmd.Flags().Duration("refresh", 0, "")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleViewBoard(cmd)
+	if exitCode(err) != exitUsage || err.Error() != "board view needs a terminal" {
+		t.Errorf("expected a usage error, got %v", err)
+	}
+}
+
+func TestBoardSourceMoveReadOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected no changes sent with a read-only token, got %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{BaseURL: server.URL, Permission: "read"}
+	client, err := testApp.NewClient("/test-account", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testApp.Client = client
+	source := &boardSource{a: testApp, boardID: "board-1"}
+
+	err = source.Move(context.Background(), fizzy.Card{Number: 1}, ui.Lane{Kind: ui.LaneDone})
+	if exitCode(err) != exitPermission || !strings.Contains(err.Error(), app.ReadOnlyMessage) {
+		t.Errorf("expected a read-only error, got %v", err)
+	}
+}
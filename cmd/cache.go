@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local response cache",
+	Long: `Manage the local cache of API responses.
+
+GET responses are cached under ~/.cache/fizzy-cli and revalidated with the
+API on every request, so cached data is never stale. Use --no-cache to bypass
+the cache for a single command.`,
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cached responses",
+	Long:  `Remove all cached API responses, for every account and token`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleClearCache(cmd)
+	},
+}
+
+func handleClearCache(cmd *cobra.Command) error {
+	if err := app.ClearCache(); err != nil {
+		return fmt.Errorf("clearing cache: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Cache cleared\n")
+	return nil
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheClearCmd)
+}
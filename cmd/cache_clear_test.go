@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestCacheClearCommand(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	entry := filepath.Join(home, ".cache", "fizzy-cli", "scope", "entry.json")
+	if err := os.MkdirAll(filepath.Dir(entry), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(entry, []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &cobra.Command{}
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleClearCache(cmd); err != nil {
+		t.Fatalf("handleClearCache failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".cache", "fizzy-cli")); !os.IsNotExist(err) {
+		t.Errorf("expected cache directory to be removed, got %v", err)
+	}
+	if out.String() != "✓ Cache cleared\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestCacheClearCommandNoCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := handleClearCache(&cobra.Command{}); err != nil {
+		t.Fatalf("handleClearCache failed: %v", err)
+	}
+}
@@ -6,7 +6,11 @@ import "github.com/spf13/cobra"
 var cardCmd = &cobra.Command{
 	Use:   "card",
 	Short: "Manage cards",
-	Long:  `Manage cards in Fizzy`,
+	Long: `Manage cards in Fizzy.
+
+Cards can be given by number, as #42, or by URL, e.g.
+https://app.fizzy.do/897362094/cards/42. A URL for a card in another account
+runs the command in that account.`,
 }
 
 func init() {
@@ -1,47 +1,50 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
 )
 
 var cardAssignCmd = &cobra.Command{
-	Use:   "assign <card_number> <user_id>",
+	Use:   "assign <card_number> [user]",
 	Short: "Assign a user to a card",
 	Long: `Assign or unassign a user to/from a card.
 
-Use "me" as the user_id to assign the card to yourself.`,
-	Args: cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleAssignCard(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
+The user can be given by ID, name or email address. Use "me" to assign the
+card to yourself. Run on a terminal without a user, it asks which users to
+toggle.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 1 {
+			return handlePickAssignees(cmd, args[0])
 		}
+		return handleAssignCard(cmd, args[0], args[1])
 	},
 }
 
 func handleAssignCard(cmd *cobra.Command, cardNumber, userID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	if userID == "me" {
-		if a.Config.CurrentUserID == "" {
-			return fmt.Errorf("current user ID not available, please run 'fizzy login' first")
+		if userID, err = currentUserID(cmd.Context(), a); err != nil {
+			return err
 		}
-		userID = a.Config.CurrentUserID
+		err = a.Client.AssignCard(cmd.Context(), cardNum, userID)
+	} else {
+		userID, err = forUser(cmd, a, userID, app.MatchExact, func(id string) error {
+			return a.Client.AssignCard(cmd.Context(), cardNum, id)
+		})
 	}
-
-	err = a.Client.AssignCard(context.Background(), cardNum, userID)
 	if err != nil {
 		return fmt.Errorf("assigning card: %w", err)
 	}
@@ -50,6 +53,30 @@ func handleAssignCard(cmd *cobra.Command, cardNumber, userID string) error {
 	return nil
 }
 
+// handlePickAssignees toggles the assignment of the users picked on the
+// terminal.
+func handlePickAssignees(cmd *cobra.Command, cardNumber string) error {
+	cardNum, err := parseCardNumber(cmd, cardNumber)
+	if err != nil {
+		return fmt.Errorf("invalid card number: %w", err)
+	}
+
+	users, err := pickUsers(cmd, "missing user: give it after the card number")
+	if err != nil {
+		return err
+	}
+
+	a := app.FromContext(cmd.Context())
+	for _, user := range users {
+		if err := a.Client.AssignCard(cmd.Context(), cardNum, user.ID); err != nil {
+			return fmt.Errorf("assigning card: %w", err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Card #%d assignment toggled for %s\n", cardNum, user.Name)
+	}
+	return nil
+}
+
 func init() {
+	cardAssignCmd.ValidArgsFunction = completeArgs(completeCards, completeUsers)
 	cardCmd.AddCommand(cardAssignCmd)
 }
@@ -128,3 +128,19 @@ func TestCardAssignCommandMeWithoutUserID(t *testing.T) {
 		t.Errorf("expected 'current user ID not available' error, got %v", err)
 	}
 }
+
+func TestCardAssignCommandMissingUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected no requests without a terminal to pick on, got %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := cardAssignCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handlePickAssignees(cmd, "12")
+	if exitCode(err) != exitUsage || err.Error() != "missing user: give it after the card number" {
+		t.Errorf("expected a usage error for the missing user, got %v", err)
+	}
+}
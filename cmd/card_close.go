@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardCloseCmd = &cobra.Command{
 	Short: "Close a card",
 	Long:  `Close an existing card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCloseCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCloseCard(cmd, args[0])
 	},
 }
 
 func handleCloseCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.CloseCard(context.Background(), cardNum)
+	err = a.Client.CloseCard(cmd.Context(), cardNum)
 	if err != nil {
 		return fmt.Errorf("closing card: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleCloseCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardCloseCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardCloseCmd)
 }
@@ -90,3 +90,27 @@ func TestCardCloseCommandAPIError(t *testing.T) {
 		t.Errorf("expected API error, got %v", err)
 	}
 }
+
+func TestCardCloseCommandNotRetried(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	client.HTTPClient.Transport = app.NewRetryTransport(nil, 3)
+	testApp := &app.App{Client: client}
+
+	cmd := cardCloseCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleCloseCard(cmd, "123"); err == nil {
+		t.Errorf("expected error for API failure")
+	}
+	if attempts != 1 {
+		t.Errorf("expected POST to be sent once, got %d attempts", attempts)
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -12,21 +13,35 @@ import (
 var cardCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new card",
-	Long:  `Create a new card in the selected board`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateCard(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	Long: `Create a new card in the selected board.
+
+In a repository with a local config (.fizzy.json), new cards get its tags
+unless --tag-id is given, and are assigned to its assignee unless
+--assignee is given.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateCard(cmd)
 	},
 }
 
+// cardCreateFlags maps the fields of a new card to the flags setting them.
+var cardCreateFlags = map[string]string{
+	"title":          "title",
+	"description":    "description",
+	"status":         "status",
+	"image":          "image-url",
+	"image_url":      "image-url",
+	"tag_ids":        "tag-id",
+	"created_at":     "created-at",
+	"last_active_at": "last-active-at",
+}
+
 func handleCreateCard(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if a.Config.SelectedBoard == "" {
+	if a.SelectedBoard() == "" {
 		return fmt.Errorf("no board selected")
 	}
 
@@ -38,6 +53,47 @@ func handleCreateCard(cmd *cobra.Command) error {
 	tagIDs, _ := cmd.Flags().GetStringSlice("tag-id")
 	createdAt, _ := cmd.Flags().GetString("created-at")
 	lastActiveAt, _ := cmd.Flags().GetString("last-active-at")
+	assignee, _ := cmd.Flags().GetString("assignee")
+	if !cmd.Flags().Changed("assignee") && a.Local != nil {
+		assignee = a.Local.Assignee
+	}
+
+	if status != "" {
+		if err := validateOneOf("status", status, cardStatuses); err != nil {
+			return err
+		}
+	}
+	if createdAt != "" {
+		if err := validateTimestamp("created-at", createdAt); err != nil {
+			return err
+		}
+	}
+	if lastActiveAt != "" {
+		if err := validateTimestamp("last-active-at", lastActiveAt); err != nil {
+			return err
+		}
+	}
+
+	if !cmd.Flags().Changed("tag-id") && a.Local != nil && len(a.Local.Tags) > 0 {
+		ids, err := a.ResolveTagIDs(cmd.Context(), a.Local.Tags)
+		if err != nil {
+			return fmt.Errorf("%s: %w", a.Local.Path(), err)
+		}
+		tagIDs = ids
+	}
+
+	// Looked up first, so that a bad assignee doesn't leave a card behind.
+	var assigneeID string
+	if assignee != "" {
+		id, err := resolveUserID(cmd, a, assignee, app.MatchExact)
+		if err != nil {
+			if !cmd.Flags().Changed("assignee") {
+				return fmt.Errorf("%s: %w", a.Local.Path(), err)
+			}
+			return fmt.Errorf("assigning card: %w", err)
+		}
+		assigneeID = id
+	}
 
 	payload := fizzy.CreateCardPayload{
 		Title:        title,
@@ -49,24 +105,96 @@ func handleCreateCard(cmd *cobra.Command) error {
 		LastActiveAt: lastActiveAt,
 	}
 
-	err := a.Client.CreateCard(context.Background(), payload)
+	err := a.Client.CreateCard(cmd.Context(), payload)
 	if err != nil {
-		return fmt.Errorf("creating card: %w", err)
+		return wrapAPIError("creating card", err, cardCreateFlags)
+	}
+
+	if assigneeID != "" {
+		return assignCreatedCard(cmd, a, title, assigneeID)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Card '%s' created successfully\n", title)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		return findCreatedCard(cmd.Context(), a, title)
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Card '%s' created successfully\n", title)
+		return nil
+	})
 }
 
 func init() {
 	cardCreateCmd.Flags().StringP("title", "t", "", "Card title (required)")
 	cardCreateCmd.MarkFlagRequired("title")
 	cardCreateCmd.Flags().StringP("description", "d", "", "Card description")
-	cardCreateCmd.Flags().String("status", "", "Card status")
+	cardCreateCmd.Flags().String("status", "", "Card status: drafted or published")
 	cardCreateCmd.Flags().String("image-url", "", "Card image URL")
 	cardCreateCmd.Flags().StringSlice("tag-id", []string{}, "Tag ID (can be used multiple times)")
-	cardCreateCmd.Flags().String("created-at", "", "Creation timestamp")
-	cardCreateCmd.Flags().String("last-active-at", "", "Last active timestamp")
+	cardCreateCmd.Flags().String("created-at", "", "Creation timestamp (ISO 8601)")
+	cardCreateCmd.Flags().String("last-active-at", "", "Last active timestamp (ISO 8601)")
+	cardCreateCmd.Flags().String("assignee", "", `User ID, name or email to assign the card to, or "me"`)
+	cardCreateCmd.RegisterFlagCompletionFunc("tag-id", completeTagIDs)
+	cardCreateCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
 
 	cardCmd.AddCommand(cardCreateCmd)
 }
+
+// findCreatedCard looks up the card that was just created. It works around
+// the client's CreateCard, which drops the Location header the API answers
+// with, so the card is looked for by title among the newest cards on the
+// selected board, created by the current user when known. With more than one
+// card of that title it can't tell which is new, and returns an error rather
+// than guess.
+func findCreatedCard(ctx context.Context, a *app.App, title string) (*fizzy.Card, error) {
+	filters := fizzy.CardFilters{
+		BoardIDs: []string{a.SelectedBoard()},
+		SortedBy: "newest",
+		Limit:    10,
+	}
+	if a.CurrentUserID() != "" {
+		filters.CreatorIDs = []string{a.CurrentUserID()}
+	}
+
+	cards, err := a.Client.GetCards(ctx, &filters)
+	if err != nil {
+		return nil, fmt.Errorf("fetching created card: %w", err)
+	}
+
+	var found []fizzy.Card
+	for _, card := range cards {
+		if card.Title == title {
+			found = append(found, card)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("created card '%s' not found", title)
+	case 1:
+		return &found[0], nil
+	}
+	numbers := make([]string, len(found))
+	for i, card := range found {
+		numbers[i] = fmt.Sprintf("#%d", card.Number)
+	}
+	return nil, fmt.Errorf("card created, but can't tell which of cards %s titled '%s' is the new one", strings.Join(numbers, ", "), title)
+}
+
+// assignCreatedCard assigns the card that was just created to the user with
+// ID assigneeID, and prints it.
+func assignCreatedCard(cmd *cobra.Command, a *app.App, title, assigneeID string) error {
+	card, err := findCreatedCard(cmd.Context(), a, title)
+	if err != nil {
+		return fmt.Errorf("%w; assign it with 'fizzy card assign'", err)
+	}
+
+	if err := a.Client.AssignCard(cmd.Context(), card.Number, assigneeID); err != nil {
+		return fmt.Errorf("assigning card: %w", err)
+	}
+
+	return printFetchedResult(cmd, func() (any, error) {
+		return a.Client.GetCard(cmd.Context(), card.Number)
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Card '%s' created successfully and assigned to user %s\n", title, assigneeID)
+		return nil
+	})
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -12,6 +13,7 @@ import (
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/config"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestCardCreateCommandSuccess(t *testing.T) {
@@ -82,8 +84,8 @@ func TestCardCreateCommandWithAllFields(t *testing.T) {
 		if cardPayload.Title != "Fix bug" {
 			t.Errorf("expected title 'Fix bug', got %s", cardPayload.Title)
 		}
-		if cardPayload.Status != "in_progress" {
-			t.Errorf("expected status 'in_progress', got %s", cardPayload.Status)
+		if cardPayload.Status != "drafted" {
+			t.Errorf("expected status 'drafted', got %s", cardPayload.Status)
 		}
 		if cardPayload.ImageURL != "https://example.com/image.jpg" {
 			t.Errorf("expected image URL 'https://example.com/image.jpg', got %s", cardPayload.ImageURL)
@@ -106,7 +108,7 @@ func TestCardCreateCommandWithAllFields(t *testing.T) {
 	cmd.SetContext(testApp.ToContext(context.Background()))
 	cmd.ParseFlags([]string{
 		"--title", "Fix bug",
-		"--status", "in_progress",
+		"--status", "drafted",
 		"--image-url", "https://example.com/image.jpg",
 		"--tag-id", "tag-1",
 		"--tag-id", "tag-2",
@@ -184,3 +186,242 @@ func TestCardCreateCommandAPIError(t *testing.T) {
 		t.Errorf("expected API error, got %v", err)
 	}
 }
+
+func TestCardCreateCommandJSONOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			if r.URL.Path != "/test-account/cards" {
+				t.Errorf("expected /test-account/cards, got %s", r.URL.Path)
+			}
+			if r.URL.Query().Get("sorted_by") != "newest" {
+				t.Errorf("expected sorted_by=newest, got %s", r.URL.Query().Get("sorted_by"))
+			}
+			creatorIDs := r.URL.Query()["creator_ids[]"]
+			if len(creatorIDs) != 1 || creatorIDs[0] != "user-123" {
+				t.Errorf("expected creator_ids[]=user-123, got %v", creatorIDs)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]fizzy.Card{
+				{ID: "card-2", Number: 2, Title: "Other card"},
+				{ID: "card-1", Number: 1, Title: "Implement feature"},
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "board-123", "test-token")
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123", CurrentUserID: "user-123"},
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("title", "t", "", "Card title (required)")
+	cmd.Flags().StringP("output", "o", "text", "Output format")
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--title", "Implement feature", "--output", "json"})
+
+	if err := handleCreateCard(cmd); err != nil {
+		t.Fatalf("handleCreateCard failed: %v", err)
+	}
+
+	var card fizzy.Card
+	if err := json.Unmarshal(buf.Bytes(), &card); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if card.ID != "card-1" {
+		t.Errorf("expected created card card-1, got %s", card.ID)
+	}
+}
+
+func TestCardCreateCommandInvalidTimestamp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected no request, got %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "board-123", "test-token")
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := cardCreateCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{
+		"--title", "Test",
+		"--created-at", "yesterday",
+	})
+	t.Cleanup(func() { cmd.Flags().Set("created-at", "") })
+
+	err := handleCreateCard(cmd)
+	if err == nil {
+		t.Fatal("expected error for invalid timestamp")
+	}
+	expected := "--created-at: invalid timestamp 'yesterday', expected ISO 8601 (e.g. 2025-12-05 or 2025-12-05T19:38:48Z)"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+}
+
+func newCardCreateCmd(args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("title", "", "")
+	cmd.Flags().String("description", "", "")
+	cmd.Flags().String("status", "", "")
+	cmd.Flags().String("image-url", "", "")
+	cmd.Flags().StringSlice("tag-id", nil, "")
+	cmd.Flags().String("created-at", "", "")
+	cmd.Flags().String("last-active-at", "", "")
+	cmd.Flags().String("assignee", "", "")
+	cmd.ParseFlags(args)
+	return cmd
+}
+
+func TestCardCreateCommandLocalDefaults(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.URL.Path == "/test-account/tags":
+			json.NewEncoder(w).Encode([]fizzy.Tag{{ID: "tag-1", Title: "CLI"}, {ID: "tag-2", Title: "bug"}})
+		case r.URL.Path == "/test-account/users":
+			json.NewEncoder(w).Encode([]fizzy.User{{ID: "user-9", Name: "Jane Doe"}})
+		case r.Method == http.MethodPost && r.URL.Path == "/test-account/boards/board-123/cards":
+			var payload map[string]fizzy.CreateCardPayload
+			json.NewDecoder(r.Body).Decode(&payload)
+			if got := payload["card"].TagIDS; len(got) != 1 || got[0] != "tag-1" {
+				t.Errorf("expected the local config's tag, got %v", got)
+			}
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/test-account/cards":
+			json.NewEncoder(w).Encode([]fizzy.Card{{Number: 7, Title: "Fix it"}})
+		case r.URL.Path == "/test-account/cards/7/assignments":
+			var payload map[string]string
+			json.NewDecoder(r.Body).Decode(&payload)
+			if payload["assignee_id"] != "user-9" {
+				t.Errorf("expected the local config's assignee, got %v", payload)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-123"},
+		Local:  &config.LocalConfig{Tags: []string{"#cli"}, Assignee: "user-9"},
+	}
+
+	cmd := newCardCreateCmd("--title", "Fix it")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleCreateCard(cmd); err != nil {
+		t.Fatalf("handleCreateCard failed: %v", err)
+	}
+	if len(requests) != 5 {
+		t.Errorf("expected tags, users, create, find and assign requests, got %v", requests)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("assigned to user user-9")) {
+		t.Errorf("expected the assignment to be reported, got %q", out.String())
+	}
+}
+
+func TestCardCreateCommandFlagsOverrideLocalDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/test-account/boards/board-123/cards" {
+			t.Errorf("expected only the card to be created, got %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]fizzy.CreateCardPayload
+		json.NewDecoder(r.Body).Decode(&payload)
+		if got := payload["card"].TagIDS; len(got) != 1 || got[0] != "tag-2" {
+			t.Errorf("expected the flag's tag, got %v", got)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-123"},
+		Local:  &config.LocalConfig{Tags: []string{"cli"}, Assignee: "user-9"},
+	}
+
+	cmd := newCardCreateCmd("--title", "Fix it", "--tag-id", "tag-2", "--assignee", "")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	if err := handleCreateCard(cmd); err != nil {
+		t.Fatalf("handleCreateCard failed: %v", err)
+	}
+}
+
+func TestCardCreateCommandUnknownAssignee(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/users" {
+			t.Errorf("expected no card created for an unknown assignee, got %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]fizzy.User{{ID: "user-9", Name: "Jane Doe"}})
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := newCardCreateCmd("--title", "Fix it", "--assignee", "Jane")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	err := handleCreateCard(cmd)
+	want := "assigning card: user 'Jane' not found, did you mean Jane Doe (user-9)?"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
+
+func TestCardCreateCommandAmbiguousCreatedCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			json.NewEncoder(w).Encode([]fizzy.Card{
+				{ID: "card-3", Number: 3, Title: "Fix it"},
+				{ID: "card-1", Number: 1, Title: "Fix it"},
+			})
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := newCardCreateCmd("--title", "Fix it")
+	cmd.Flags().StringP("output", "o", "text", "Output format")
+	cmd.ParseFlags([]string{"--output", "json"})
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	err := handleCreateCard(cmd)
+	if err == nil || err.Error() != "card created, but can't tell which of cards #3, #1 titled 'Fix it' is the new one" {
+		t.Errorf("expected the created card to be ambiguous, got %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no card printed, got %q", out.String())
+	}
+}
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardGoldenCmd = &cobra.Command{
 	Short: "Mark a card as golden",
 	Long:  `Mark an existing card as golden`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleGoldenCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleGoldenCard(cmd, args[0])
 	},
 }
 
 func handleGoldenCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.MarkCardGolden(context.Background(), cardNum)
+	err = a.Client.MarkCardGolden(cmd.Context(), cardNum)
 	if err != nil {
 		return fmt.Errorf("marking card as golden: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleGoldenCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardGoldenCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardGoldenCmd)
 }
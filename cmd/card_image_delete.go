@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardImageDeleteCmd = &cobra.Command{
 	Short: "Delete a card's image",
 	Long:  `Remove the image attached to a card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteCardImage(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteCardImage(cmd, args[0])
 	},
 }
 
 func handleDeleteCardImage(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.DeleteCardImage(context.Background(), cardNum); err != nil {
+	if err := a.Client.DeleteCardImage(cmd.Context(), cardNum); err != nil {
 		return fmt.Errorf("deleting card image: %w", err)
 	}
 
@@ -41,5 +37,6 @@ func handleDeleteCardImage(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardImageDeleteCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardImageCmd.AddCommand(cardImageDeleteCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -28,38 +27,46 @@ Filter options:
   --closed-in         Filter by closure date: today, yesterday, thisweek, lastweek, thismonth, lastmonth, thisyear, lastyear
   --search            Search terms (can be used multiple times)
   --limit             Maximum number of cards to return (0 = no limit, fetches all pages)`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListCards(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListCards(cmd)
 	},
 }
 
 func handleListCards(cmd *cobra.Command) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if a.Config.SelectedBoard == "" {
+	if a.SelectedBoard() == "" {
 		return fmt.Errorf("no board selected")
 	}
 
 	filters := fizzy.CardFilters{
-		BoardIDs: []string{a.Config.SelectedBoard},
+		BoardIDs: []string{a.SelectedBoard()},
 	}
 
-	if tags, _ := cmd.Flags().GetStringSlice("tag"); len(tags) > 0 {
-		filters.TagIDs = tags
+	var err error
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	if filters.TagIDs, err = a.ResolveTagIDs(cmd.Context(), tags); err != nil {
+		return err
 	}
-	if assignees, _ := cmd.Flags().GetStringSlice("assignee"); len(assignees) > 0 {
-		filters.AssigneeIDs = assignees
+	assignees, _ := cmd.Flags().GetStringSlice("assignee")
+	if filters.AssigneeIDs, err = resolveUserIDs(cmd, a, assignees); err != nil {
+		return err
 	}
-	if creators, _ := cmd.Flags().GetStringSlice("creator"); len(creators) > 0 {
-		filters.CreatorIDs = creators
+	creators, _ := cmd.Flags().GetStringSlice("creator")
+	if filters.CreatorIDs, err = resolveUserIDs(cmd, a, creators); err != nil {
+		return err
 	}
-	if closers, _ := cmd.Flags().GetStringSlice("closer"); len(closers) > 0 {
-		filters.CloserIDs = closers
+	closers, _ := cmd.Flags().GetStringSlice("closer")
+	if filters.CloserIDs, err = resolveUserIDs(cmd, a, closers); err != nil {
+		return err
 	}
 	if cardIDs, _ := cmd.Flags().GetStringSlice("card"); len(cardIDs) > 0 {
 		filters.CardIDs = cardIDs
@@ -85,28 +92,29 @@ func handleListCards(cmd *cobra.Command) error {
 		filters.AssignmentStatus = "unassigned"
 	}
 
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		filters.Limit = limit
 	}
 
-	cards, err := a.Client.GetCards(context.Background(), &filters)
+	cards, err := a.Client.GetCards(cmd.Context(), &filters)
 	if err != nil {
 		return fmt.Errorf("fetching cards: %w", err)
 	}
 
-	if len(cards) == 0 {
-		fmt.Println("No cards found")
-		return nil
-	}
-
-	return ui.DisplayCards(cards)
+	return printList(cmd, cards, func() error {
+		if len(cards) == 0 {
+			fmt.Println("No cards found")
+			return nil
+		}
+		return ui.DisplayCards(cards)
+	})
 }
 
 func init() {
-	cardListCmd.Flags().StringSliceP("tag", "t", []string{}, "Filter by tag ID (can be used multiple times)")
-	cardListCmd.Flags().StringSliceP("assignee", "a", []string{}, "Filter by assignee user ID (can be used multiple times)")
-	cardListCmd.Flags().StringSlice("creator", []string{}, "Filter by creator user ID (can be used multiple times)")
-	cardListCmd.Flags().StringSlice("closer", []string{}, "Filter by closer user ID (can be used multiple times)")
+	cardListCmd.Flags().StringSliceP("tag", "t", []string{}, "Filter by tag ID or title (can be used multiple times)")
+	cardListCmd.Flags().StringSliceP("assignee", "a", []string{}, "Filter by assignee user ID, name, email or \"me\" (can be used multiple times)")
+	cardListCmd.Flags().StringSlice("creator", []string{}, "Filter by creator user ID, name, email or \"me\" (can be used multiple times)")
+	cardListCmd.Flags().StringSlice("closer", []string{}, "Filter by closer user ID, name, email or \"me\" (can be used multiple times)")
 	cardListCmd.Flags().StringSlice("card", []string{}, "Filter to specific card ID (can be used multiple times)")
 	cardListCmd.Flags().String("indexed-by", "", "Filter by status: all, closed, not_now, stalled, postponing_soon, golden")
 	cardListCmd.Flags().String("sorted-by", "", "Sort order: latest, newest, oldest")
@@ -115,6 +123,12 @@ func init() {
 	cardListCmd.Flags().String("closed-in", "", "Filter by closure date")
 	cardListCmd.Flags().StringSliceP("search", "s", []string{}, "Search terms (can be used multiple times)")
 	cardListCmd.Flags().IntP("limit", "l", 0, "Maximum number of cards to return (0 = no limit)")
+	cardListCmd.RegisterFlagCompletionFunc("tag", completeTags)
+	cardListCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
+	cardListCmd.RegisterFlagCompletionFunc("creator", completeUsers)
+	cardListCmd.RegisterFlagCompletionFunc("closer", completeUsers)
+
+	addListOutputFlags(cardListCmd)
 
 	cardCmd.AddCommand(cardListCmd)
 }
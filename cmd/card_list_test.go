@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -130,6 +134,145 @@ func TestCardListCommandAPIError(t *testing.T) {
 	}
 }
 
+func TestCardListCommandRetriesRateLimit(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]fizzy.Card{{Number: 1, Title: "Retried"}})
+		}
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "board-123", "test-token")
+	client.HTTPClient.Transport = app.NewRetryTransport(nil, 3)
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := cardListCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleListCards(cmd); err != nil {
+		t.Fatalf("handleListCards failed: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestCardListCommandRetriesExhausted(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("Too Many Requests"))
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "board-123", "test-token")
+	client.HTTPClient.Transport = app.NewRetryTransport(nil, 2)
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := cardListCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleListCards(cmd)
+	if err == nil {
+		t.Fatal("expected error after retries are exhausted")
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+	if code := exitCode(err); code != exitRateLimit {
+		t.Errorf("expected exit code %d, got %d", exitRateLimit, code)
+	}
+}
+
+func TestCardListCommandDebugLog(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session_token=secret-session; path=/; HttpOnly")
+		if r.URL.Query().Get("page") != "2" {
+			w.Header().Set("Link", "<"+server.URL+`/test-account/cards?page=2>; rel="next"`)
+		}
+		json.NewEncoder(w).Encode([]fizzy.Card{{Number: 1, Title: "Debugged"}})
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	client := testutil.NewTestClient(server.URL, "", "board-123", "secret-access-token")
+	client.HTTPClient.Transport = app.NewDebugTransport(nil, &log, true)
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := cardListCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleListCards(cmd); err != nil {
+		t.Fatalf("handleListCards failed: %v", err)
+	}
+
+	out := log.String()
+	for _, want := range []string{
+		"--> GET " + server.URL + "/test-account/cards",
+		"<-- 200 OK (",
+		"Authorization: Bearer secret...",
+		"Link: <" + server.URL + `/test-account/cards?page=2>; rel="next"`,
+		"Set-Cookie: session_token=[REDACTED]; path=/; HttpOnly",
+		`"title":"Debugged"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected debug log to contain %q, got:\n%s", want, out)
+		}
+	}
+	for _, secret := range []string{"secret-access-token", "secret-session"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("expected %q to be redacted, got:\n%s", secret, out)
+		}
+	}
+}
+
+func TestCardListCommandCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "board-123", "test-token")
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(ctx))
+
+	err := handleListCards(cmd)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
 func TestCardListCommandNoBoard(t *testing.T) {
 	client := testutil.NewTestClient("http://localhost", "", "", "test-token")
 	testApp := &app.App{
@@ -166,6 +309,11 @@ func TestCardListCommandNoClient(t *testing.T) {
 
 func TestCardListCommandWithTagFilter(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/cards" {
+			// The filters are looked up by name first; these are IDs.
+			json.NewEncoder(w).Encode([]any{})
+			return
+		}
 		tagIDs := r.URL.Query()["tag_ids[]"]
 		if len(tagIDs) != 2 || tagIDs[0] != "tag-123" || tagIDs[1] != "tag-456" {
 			t.Errorf("expected tag_ids[]=tag-123&tag_ids[]=tag-456, got %v", tagIDs)
@@ -193,6 +341,11 @@ func TestCardListCommandWithTagFilter(t *testing.T) {
 
 func TestCardListCommandWithAssigneeFilter(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/cards" {
+			// The filters are looked up by name first; these are IDs.
+			json.NewEncoder(w).Encode([]any{})
+			return
+		}
 		assigneeIDs := r.URL.Query()["assignee_ids[]"]
 		if len(assigneeIDs) != 1 || assigneeIDs[0] != "user-123" {
 			t.Errorf("expected assignee_ids[]=user-123, got %v", assigneeIDs)
@@ -328,6 +481,11 @@ func TestCardListCommandWithSearchFilter(t *testing.T) {
 
 func TestCardListCommandWithMultipleFilters(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/cards" {
+			// The filters are looked up by name first; these are IDs.
+			json.NewEncoder(w).Encode([]any{})
+			return
+		}
 		boardIDs := r.URL.Query()["board_ids[]"]
 		if len(boardIDs) == 0 || boardIDs[0] != "board-123" {
 			t.Errorf("expected board_ids[]=board-123, got %v", boardIDs)
@@ -371,3 +529,108 @@ func TestCardListCommandWithMultipleFilters(t *testing.T) {
 		t.Fatalf("handleListCards failed: %v", err)
 	}
 }
+
+func TestCardListCommandWithNamedFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test-account/tags":
+			json.NewEncoder(w).Encode([]fizzy.Tag{{ID: "tag-1", Title: "bug"}, {ID: "tag-2", Title: "feature"}})
+		case "/test-account/users":
+			json.NewEncoder(w).Encode([]fizzy.User{{ID: "user-1", Name: "Jane Doe", Email: "jane@example.com"}})
+		case "/test-account/cards":
+			q := r.URL.Query()
+			if got := q["tag_ids[]"]; strings.Join(got, ",") != "tag-1,tag-9" {
+				t.Errorf("expected tag_ids[]=tag-1,tag-9, got %v", got)
+			}
+			if got := q["assignee_ids[]"]; strings.Join(got, ",") != "user-1,me-id" {
+				t.Errorf("expected assignee_ids[]=user-1,me-id, got %v", got)
+			}
+			json.NewEncoder(w).Encode([]fizzy.Card{})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-123", CurrentUserID: "me-id"},
+	}
+
+	cmd := newCardListCmd()
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	// tag-9 isn't in the list, so it's taken to be an ID.
+	cmd.ParseFlags([]string{"--tag", "#Bug", "--tag", "tag-9", "--assignee", "jane@example.com", "--assignee", "me"})
+
+	if err := handleListCards(cmd); err != nil {
+		t.Fatalf("handleListCards failed: %v", err)
+	}
+}
+
+func TestCardListCommandUnknownTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/tags" {
+			t.Errorf("expected only the tags to be fetched, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]fizzy.Tag{{ID: "tag-1", Title: "frontend"}, {ID: "tag-2", Title: "backend"}})
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := newCardListCmd()
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--tag", "end"})
+
+	err := handleListCards(cmd)
+	want := "tag 'end' not found, did you mean #frontend (tag-1) or #backend (tag-2)?"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
+
+func TestCardListCommandFilterLookups(t *testing.T) {
+	tests := []struct {
+		name   string
+		tags   []fizzy.Tag
+		status int
+		tag    string
+		want   string
+	}{
+		{"unknown name", []fizzy.Tag{{ID: "tag-1", Title: "frontend"}}, http.StatusOK, "design", "tag 'design' not found"},
+		{"fetch error", nil, http.StatusInternalServerError, "design", "fetching tags: unexpected status code 500: boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/test-account/tags" {
+					t.Errorf("expected only the tags to be fetched, got %s", r.URL.Path)
+				}
+				if tt.status != http.StatusOK {
+					w.WriteHeader(tt.status)
+					w.Write([]byte("boom"))
+					return
+				}
+				json.NewEncoder(w).Encode(tt.tags)
+			}))
+			defer server.Close()
+
+			testApp := &app.App{
+				Client: testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+				Config: &config.Config{SelectedBoard: "board-123"},
+			}
+
+			cmd := newCardListCmd()
+			cmd.SetContext(testApp.ToContext(context.Background()))
+			cmd.ParseFlags([]string{"--tag", tt.tag})
+
+			err := handleListCards(cmd)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardPinCmd = &cobra.Command{
 	Short: "Pin a card",
 	Long:  `Pin a card so it appears in your pinned cards list`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handlePinCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handlePinCard(cmd, args[0])
 	},
 }
 
 func handlePinCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.PinCard(context.Background(), cardNum); err != nil {
+	if err := a.Client.PinCard(cmd.Context(), cardNum); err != nil {
 		return fmt.Errorf("pinning card: %w", err)
 	}
 
@@ -41,5 +37,6 @@ func handlePinCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardPinCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardPinCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,33 +12,34 @@ var cardReactionCreateCmd = &cobra.Command{
 	Short: "Create a reaction on a card",
 	Long:  `Create an emoji reaction (boost) on a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateCardReaction(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateCardReaction(cmd, args[0], args[1])
 	},
 }
 
 func handleCreateCardReaction(cmd *cobra.Command, cardNumber, emoji string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	_, err = a.Client.CreateCardReaction(context.Background(), cardNum, emoji)
+	reaction, err := a.Client.CreateCardReaction(cmd.Context(), cardNum, emoji)
 	if err != nil {
 		return fmt.Errorf("creating reaction: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Reaction %s created successfully\n", emoji)
-	return nil
+	return printResult(cmd, reaction, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Reaction %s created successfully\n", emoji)
+		return nil
+	})
 }
 
 func init() {
+	cardReactionCreateCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardReactionCmd.AddCommand(cardReactionCreateCmd)
 }
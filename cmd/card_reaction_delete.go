@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardReactionDeleteCmd = &cobra.Command{
 	Short: "Delete a reaction from a card",
 	Long:  `Remove your reaction (boost) from a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteCardReaction(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteCardReaction(cmd, args[0], args[1])
 	},
 }
 
 func handleDeleteCardReaction(cmd *cobra.Command, cardNumber, reactionID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.DeleteCardReaction(context.Background(), cardNum, reactionID)
+	err = a.Client.DeleteCardReaction(cmd.Context(), cardNum, reactionID)
 	if err != nil {
 		return fmt.Errorf("deleting reaction: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleDeleteCardReaction(cmd *cobra.Command, cardNumber, reactionID string)
 }
 
 func init() {
+	cardReactionDeleteCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardReactionCmd.AddCommand(cardReactionDeleteCmd)
 }
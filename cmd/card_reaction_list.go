@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
@@ -15,37 +13,38 @@ var cardReactionListCmd = &cobra.Command{
 	Short: "List reactions on a card",
 	Long:  `Retrieve and display all reactions (boosts) on a card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListCardReactions(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListCardReactions(cmd, args[0])
 	},
 }
 
 func handleListCardReactions(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	reactions, err := a.Client.GetCardReactions(context.Background(), cardNum)
+	reactions, err := a.Client.GetCardReactions(cmd.Context(), cardNum)
 	if err != nil {
 		return fmt.Errorf("fetching reactions: %w", err)
 	}
 
-	if len(reactions) == 0 {
-		fmt.Println("No reactions found")
-		return nil
-	}
-
-	return ui.DisplayReactions(reactions)
+	return printList(cmd, reactions, func() error {
+		if len(reactions) == 0 {
+			fmt.Println("No reactions found")
+			return nil
+		}
+		return ui.DisplayReactions(reactions)
+	})
 }
 
 func init() {
+	addListOutputFlags(cardReactionListCmd)
+	cardReactionListCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardReactionCmd.AddCommand(cardReactionListCmd)
 }
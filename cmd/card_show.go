@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
@@ -15,32 +13,33 @@ var cardShowCmd = &cobra.Command{
 	Short: "Show card details",
 	Long:  `Retrieve and display details for a specific card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowCard(cmd, args[0])
 	},
 }
 
 func handleShowCard(cmd *cobra.Command, cardID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	cardNumber, err := strconv.Atoi(cardID)
+	cardNumber, err := parseCardNumber(cmd, cardID)
 	if err != nil {
 		return fmt.Errorf("card ID must be a number: %w", err)
 	}
 
-	card, err := a.Client.GetCard(context.Background(), cardNumber)
+	card, err := a.Client.GetCard(cmd.Context(), cardNumber)
 	if err != nil {
 		return fmt.Errorf("fetching card: %w", err)
 	}
 
-	return ui.DisplayCard(card)
+	return printResult(cmd, card, func() error {
+		return ui.DisplayCard(card)
+	})
 }
 
 func init() {
+	cardShowCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardShowCmd)
 }
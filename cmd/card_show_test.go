@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestCardShowCommand(t *testing.T) {
@@ -93,3 +96,81 @@ func TestCardShowCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+func TestCardShowCommandCardRefs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/cards/42" {
+			t.Errorf("expected /test-account/cards/42, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(fizzy.Card{ID: "card-42", Number: 42, Title: "Pasted"})
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client:  testutil.NewTestClient(server.URL, "", "", "test-token"),
+		BaseURL: server.URL,
+		Token:   "test-token",
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	for _, ref := range []string{"42", "#42", server.URL + "/test-account/cards/42", server.URL + "/test-account/cards/42/?tab=comments#comment_1"} {
+		if err := handleShowCard(cmd, ref); err != nil {
+			t.Errorf("handleShowCard(%q) failed: %v", ref, err)
+		}
+	}
+}
+
+func TestCardShowCommandURLInOtherAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/897362094/cards/42" {
+			t.Errorf("expected /897362094/cards/42, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(fizzy.Card{ID: "card-42", Number: 42, Title: "Elsewhere", Board: fizzy.Board{ID: "board-9"}})
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client:  testutil.NewTestClient(server.URL, "", "board-123", "test-token"),
+		Config:  &config.Config{SelectedAccount: "/test-account", SelectedBoard: "board-123"},
+		BaseURL: server.URL,
+		Token:   "test-token",
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleShowCard(cmd, server.URL+"/897362094/cards/42"); err != nil {
+		t.Fatalf("handleShowCard failed: %v", err)
+	}
+	if testApp.SelectedAccount() != "/897362094" {
+		t.Errorf("expected the account to switch to /897362094, got %s", testApp.SelectedAccount())
+	}
+	if testApp.SelectedBoard() != "board-9" {
+		t.Errorf("expected the card's board to be selected, got %s", testApp.SelectedBoard())
+	}
+}
+
+func TestCardShowCommandInvalidCardRefs(t *testing.T) {
+	testApp := &app.App{
+		Client:  testutil.NewTestClient("https://fizzy.example.com", "", "", "test-token"),
+		BaseURL: "https://fizzy.example.com",
+		Token:   "test-token",
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"#abc", "card ID must be a number: strconv.Atoi: parsing \"abc\": invalid syntax"},
+		{"https://app.fizzy.do/897362094/cards/42", "isn't on fizzy.example.com"},
+		{"https://fizzy.example.com/897362094/boards/42", "isn't a card URL"},
+	}
+	for _, tt := range tests {
+		err := handleShowCard(cmd, tt.ref)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("handleShowCard(%q): expected error containing %q, got %v", tt.ref, tt.want, err)
+		}
+	}
+}
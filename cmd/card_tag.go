@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -17,27 +15,25 @@ var cardTagCmd = &cobra.Command{
 
 The tag title can be specified with or without a leading # symbol.`,
 	Args: cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleTagCard(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleTagCard(cmd, args[0], args[1])
 	},
 }
 
 func handleTagCard(cmd *cobra.Command, cardNumber, tagTitle string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	tagTitle = strings.TrimPrefix(tagTitle, "#")
 
-	err = a.Client.TagCard(context.Background(), cardNum, tagTitle)
+	err = a.Client.TagCard(cmd.Context(), cardNum, tagTitle)
 	if err != nil {
 		return fmt.Errorf("toggling tag on card: %w", err)
 	}
@@ -47,5 +43,6 @@ func handleTagCard(cmd *cobra.Command, cardNumber, tagTitle string) error {
 }
 
 func init() {
+	cardTagCmd.ValidArgsFunction = completeArgs(completeCards, completeTags)
 	cardCmd.AddCommand(cardTagCmd)
 }
@@ -1,38 +1,50 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
 )
 
 var cardTriageCmd = &cobra.Command{
-	Use:   "triage <card_number> <column_id>",
+	Use:   "triage <card_number> [column]",
 	Short: "Move a card from triage into a column",
-	Long:  `Move a card from triage into a specified column`,
-	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleTriageCard(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
+	Long: `Move a card from triage into a specified column.
+
+Run on a terminal without a column, it asks which one to move the card to.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var column string
+		if len(args) > 1 {
+			column = args[1]
 		}
+		return handleTriageCard(cmd, args[0], column)
 	},
 }
 
 func handleTriageCard(cmd *cobra.Command, cardNumber string, columnID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.TriageCard(context.Background(), cardNum, columnID)
+	if columnID == "" {
+		column, err := pickColumn(cmd, "missing column: give it after the card number")
+		if err != nil {
+			return err
+		}
+		columnID = column.ID
+	}
+
+	_, err = forColumn(cmd, a, columnID, app.MatchPrefix, func(id string) error {
+		return a.Client.TriageCard(cmd.Context(), cardNum, id)
+	})
 	if err != nil {
 		return fmt.Errorf("triaging card: %w", err)
 	}
@@ -42,5 +54,6 @@ func handleTriageCard(cmd *cobra.Command, cardNumber string, columnID string) er
 }
 
 func init() {
+	cardTriageCmd.ValidArgsFunction = completeArgs(completeCards, completeColumns)
 	cardCmd.AddCommand(cardTriageCmd)
 }
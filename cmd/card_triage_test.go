@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestCardTriageCommandColumnID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/test-account/cards/12/triage" {
+			t.Errorf("expected only POST /test-account/cards/12/triage, got %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	if err := handleTriageCard(cmd, "12", "col-2"); err != nil {
+		t.Fatalf("handleTriageCard failed: %v", err)
+	}
+}
+
+func TestCardTriageCommandColumnName(t *testing.T) {
+	var triagedTo []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test-account/boards/board-1/columns":
+			json.NewEncoder(w).Encode([]fizzy.Column{{ID: "col-1", Name: "Backlog"}, {ID: "col-2", Name: "In Progress"}})
+		case "/test-account/cards/12/triage":
+			var payload map[string]string
+			json.NewDecoder(r.Body).Decode(&payload)
+			triagedTo = append(triagedTo, payload["column_id"])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	if err := handleTriageCard(cmd, "12", "in progress"); err != nil {
+		t.Fatalf("handleTriageCard failed: %v", err)
+	}
+	if strings.Join(triagedTo, ",") != "col-2" {
+		t.Errorf("expected the name to be resolved before triaging, got %v", triagedTo)
+	}
+}
+
+func TestCardTriageCommandMissingColumn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected no requests without a terminal to pick on, got %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleTriageCard(cmd, "12", "")
+	if exitCode(err) != exitUsage || err.Error() != "missing column: give it after the card number" {
+		t.Errorf("expected a usage error for the missing column, got %v", err)
+	}
+}
+
+func TestCardTriageCommandURLInOtherAccount(t *testing.T) {
+	var triagedTo []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/897362094/cards/42":
+			json.NewEncoder(w).Encode(fizzy.Card{Number: 42, Board: fizzy.Board{ID: "board-9"}})
+		case "/897362094/boards/board-9/columns":
+			json.NewEncoder(w).Encode([]fizzy.Column{{ID: "col-7", Name: "Doing"}})
+		case "/897362094/cards/42/triage":
+			var payload map[string]string
+			json.NewDecoder(r.Body).Decode(&payload)
+			triagedTo = append(triagedTo, payload["column_id"])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client:  testutil.NewTestClient(server.URL, "", "board-1", "test-token"),
+		BaseURL: server.URL,
+		Token:   "test-token",
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	if err := handleTriageCard(cmd, server.URL+"/897362094/cards/42", "doing"); err != nil {
+		t.Fatalf("handleTriageCard failed: %v", err)
+	}
+	if strings.Join(triagedTo, ",") != "col-7" {
+		t.Errorf("expected the card triaged to col-7, got %v", triagedTo)
+	}
+}
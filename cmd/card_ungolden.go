@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardUngoldenCmd = &cobra.Command{
 	Short: "Remove golden status from a card",
 	Long:  `Remove golden status from an existing card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUngoldenCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUngoldenCard(cmd, args[0])
 	},
 }
 
 func handleUngoldenCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.UnmarkCardGolden(context.Background(), cardNum)
+	err = a.Client.UnmarkCardGolden(cmd.Context(), cardNum)
 	if err != nil {
 		return fmt.Errorf("removing golden status: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleUngoldenCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardUngoldenCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardUngoldenCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardUnpinCmd = &cobra.Command{
 	Short: "Unpin a card",
 	Long:  `Remove a card from your pinned cards list`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUnpinCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUnpinCard(cmd, args[0])
 	},
 }
 
 func handleUnpinCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.UnpinCard(context.Background(), cardNum); err != nil {
+	if err := a.Client.UnpinCard(cmd.Context(), cardNum); err != nil {
 		return fmt.Errorf("unpinning card: %w", err)
 	}
 
@@ -41,5 +37,6 @@ func handleUnpinCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardUnpinCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardUnpinCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardUntriagedCmd = &cobra.Command{
 	Short: "Send a card back to triage",
 	Long:  `Send an existing card back to the triage column`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUntriagedCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUntriagedCard(cmd, args[0])
 	},
 }
 
 func handleUntriagedCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.UnTriageCard(context.Background(), cardNum)
+	err = a.Client.UnTriageCard(cmd.Context(), cardNum)
 	if err != nil {
 		return fmt.Errorf("sending card back to triage: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleUntriagedCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardUntriagedCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardUntriagedCmd)
 }
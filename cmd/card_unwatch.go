@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var cardUnwatchCmd = &cobra.Command{
 	Short: "Unsubscribe from card notifications",
 	Long:  `Unsubscribe from notifications for an existing card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUnwatchCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUnwatchCard(cmd, args[0])
 	},
 }
 
 func handleUnwatchCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.UnwatchCard(context.Background(), cardNum)
+	err = a.Client.UnwatchCard(cmd.Context(), cardNum)
 	if err != nil {
 		return fmt.Errorf("unwatching card: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleUnwatchCard(cmd *cobra.Command, cardNumber string) error {
 }
 
 func init() {
+	cardUnwatchCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardUnwatchCmd)
 }
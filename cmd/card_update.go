@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -15,22 +13,20 @@ var cardUpdateCmd = &cobra.Command{
 	Short: "Update a card",
 	Long:  `Update an existing card's details`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateCard(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateCard(cmd, args[0])
 	},
 }
 
 func handleUpdateCard(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	// Build payload only with flags that were explicitly set
@@ -47,6 +43,9 @@ func handleUpdateCard(cmd *cobra.Command, cardNumber string) error {
 	}
 	if cmd.Flags().Changed("status") {
 		payload.Status, _ = cmd.Flags().GetString("status")
+		if err := validateOneOf("status", payload.Status, cardStatuses); err != nil {
+			return err
+		}
 		hasChanges = true
 	}
 	if cmd.Flags().Changed("tag-id") {
@@ -55,6 +54,9 @@ func handleUpdateCard(cmd *cobra.Command, cardNumber string) error {
 	}
 	if cmd.Flags().Changed("last-active-at") {
 		payload.LastActiveAt, _ = cmd.Flags().GetString("last-active-at")
+		if err := validateTimestamp("last-active-at", payload.LastActiveAt); err != nil {
+			return err
+		}
 		hasChanges = true
 	}
 
@@ -62,21 +64,25 @@ func handleUpdateCard(cmd *cobra.Command, cardNumber string) error {
 		return fmt.Errorf("must provide at least one flag to update (--title, --description, --status, --tag-id, or --last-active-at)")
 	}
 
-	card, err := a.Client.UpdateCard(context.Background(), cardNum, payload)
+	card, err := a.Client.UpdateCard(cmd.Context(), cardNum, payload)
 	if err != nil {
 		return fmt.Errorf("updating card: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Card #%d updated successfully\n", card.Number)
-	return nil
+	return printResult(cmd, card, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Card #%d updated successfully\n", card.Number)
+		return nil
+	})
 }
 
 func init() {
 	cardUpdateCmd.Flags().StringP("title", "t", "", "Card title")
 	cardUpdateCmd.Flags().StringP("description", "d", "", "Card description")
-	cardUpdateCmd.Flags().String("status", "", "Card status")
+	cardUpdateCmd.Flags().String("status", "", "Card status: drafted or published")
 	cardUpdateCmd.Flags().StringSlice("tag-id", []string{}, "Tag ID (can be used multiple times)")
-	cardUpdateCmd.Flags().String("last-active-at", "", "Last active timestamp")
+	cardUpdateCmd.Flags().String("last-active-at", "", "Last active timestamp (ISO 8601)")
+	cardUpdateCmd.RegisterFlagCompletionFunc("tag-id", completeTagIDs)
 
+	cardUpdateCmd.ValidArgsFunction = completeArgs(completeCards)
 	cardCmd.AddCommand(cardUpdateCmd)
 }
@@ -6,7 +6,10 @@ import "github.com/spf13/cobra"
 var columnCmd = &cobra.Command{
 	Use:   "column",
 	Short: "Manage columns",
-	Long:  `Manage columns in Fizzy`,
+	Long: `Manage columns in Fizzy.
+
+Columns of the selected board can be given by ID, name, or the start of a
+name.`,
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -11,42 +10,52 @@ import (
 )
 
 var columnCardsCmd = &cobra.Command{
-	Use:   "cards <column_id>",
+	Use:   "cards <column>",
 	Short: "List cards in a column",
 	Long:  `Retrieve and display cards in a specific column of the selected board`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListColumnCards(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListColumnCards(cmd, args[0])
 	},
 }
 
 func handleListColumnCards(cmd *cobra.Command, columnID string) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	opts := &fizzy.ListOptions{}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		opts.Limit = limit
 	}
 
-	cards, err := a.Client.GetColumnCards(context.Background(), columnID, opts)
+	var cards []fizzy.Card
+	_, err := forColumn(cmd, a, columnID, app.MatchPrefix, func(id string) (err error) {
+		cards, err = a.Client.GetColumnCards(cmd.Context(), id, opts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching column cards: %w", err)
 	}
 
-	if len(cards) == 0 {
-		fmt.Fprintln(cmd.OutOrStdout(), "No cards found")
-		return nil
-	}
-
-	return ui.DisplayCards(cards)
+	return printList(cmd, cards, func() error {
+		if len(cards) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No cards found")
+			return nil
+		}
+		return ui.DisplayCards(cards)
+	})
 }
 
 func init() {
 	columnCardsCmd.Flags().IntP("limit", "l", 0, "Maximum number of cards to return (0 = no limit)")
+	addListOutputFlags(columnCardsCmd)
+	columnCardsCmd.ValidArgsFunction = completeArgs(completeColumns)
 	columnCmd.AddCommand(columnCardsCmd)
 }
@@ -31,17 +31,22 @@ var columnCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new column",
 	Long:  `Create a new column in the selected board. Color is optional.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateColumn(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateColumn(cmd)
 	},
 }
 
+// columnCreateFlags maps the fields of a new column to the flags setting
+// them.
+var columnCreateFlags = map[string]string{
+	"name":  "name",
+	"color": "color",
+}
+
 func handleCreateColumn(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	// Read flag values directly from command
@@ -61,13 +66,17 @@ func handleCreateColumn(cmd *cobra.Command) error {
 		payload.Color = &color
 	}
 
-	err := a.Client.CreateColumn(context.Background(), payload)
+	err := a.Client.CreateColumn(cmd.Context(), payload)
 	if err != nil {
-		return fmt.Errorf("creating column: %w", err)
+		return wrapAPIError("creating column", err, columnCreateFlags)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Column '%s' created successfully\n", name)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		return findCreatedColumn(cmd.Context(), a, name)
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Column '%s' created successfully\n", name)
+		return nil
+	})
 }
 
 func init() {
@@ -77,3 +86,24 @@ func init() {
 
 	columnCmd.AddCommand(columnCreateCmd)
 }
+
+// findCreatedColumn looks up the column that was just created, since the API
+// only answers with a Location header. The most recently created match wins.
+func findCreatedColumn(ctx context.Context, a *app.App, name string) (*fizzy.Column, error) {
+	columns, err := a.Client.GetColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching created column: %w", err)
+	}
+
+	var created *fizzy.Column
+	for i := range columns {
+		if columns[i].Name == name && (created == nil || columns[i].CreatedAt > created.CreatedAt) {
+			created = &columns[i]
+		}
+	}
+
+	if created == nil {
+		return nil, fmt.Errorf("created column '%s' not found", name)
+	}
+	return created, nil
+}
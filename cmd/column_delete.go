@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -9,24 +8,24 @@ import (
 )
 
 var columnDeleteCmd = &cobra.Command{
-	Use:   "delete <column_id>",
+	Use:   "delete <column>",
 	Short: "Delete a column",
 	Long:  `Delete a column. Only board administrators can delete columns.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteColumn(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteColumn(cmd, args[0])
 	},
 }
 
 func handleDeleteColumn(cmd *cobra.Command, columnID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err := a.Client.DeleteColumn(context.Background(), columnID)
+	columnID, err := forColumn(cmd, a, columnID, app.MatchExact, func(id string) error {
+		return a.Client.DeleteColumn(cmd.Context(), id)
+	})
 	if err != nil {
 		return fmt.Errorf("deleting column: %w", err)
 	}
@@ -36,5 +35,6 @@ func handleDeleteColumn(cmd *cobra.Command, columnID string) error {
 }
 
 func init() {
+	columnDeleteCmd.ValidArgsFunction = completeArgs(completeColumns)
 	columnCmd.AddCommand(columnDeleteCmd)
 }
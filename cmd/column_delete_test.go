@@ -52,7 +52,7 @@ func TestColumnDeleteCommandNotFound(t *testing.T) {
 	cmd := columnDeleteCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
-	err := handleDeleteColumn(cmd, "nonexistent-col")
+	err := handleDeleteColumn(cmd, "col-404")
 	if err == nil {
 		t.Errorf("expected error for column not found")
 	}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,32 +12,32 @@ var columnListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all columns",
 	Long:  `Retrieve and display all columns in the selected board`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListColumns(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListColumns(cmd)
 	},
 }
 
 func handleListColumns(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	columns, err := a.Client.GetColumns(context.Background())
+	columns, err := a.Client.GetColumns(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching columns: %w", err)
 	}
 
-	if len(columns) == 0 {
-		fmt.Println("No columns found")
-		return nil
-	}
-
-	return ui.DisplayColumns(columns)
+	return printList(cmd, columns, func() error {
+		if len(columns) == 0 {
+			fmt.Println("No columns found")
+			return nil
+		}
+		return ui.DisplayColumns(columns)
+	})
 }
 
 func init() {
+	addListOutputFlags(columnListCmd)
 	columnCmd.AddCommand(columnListCmd)
 }
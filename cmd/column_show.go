@@ -1,40 +1,45 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var columnShowCmd = &cobra.Command{
-	Use:   "show <column_id>",
+	Use:   "show <column>",
 	Short: "Show column details",
 	Long:  `Retrieve and display detailed information about a specific column`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowColumnDetails(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowColumnDetails(cmd, args[0])
 	},
 }
 
 func handleShowColumnDetails(cmd *cobra.Command, columnID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	column, err := a.Client.GetColumn(context.Background(), columnID)
+	var column *fizzy.Column
+	_, err := forColumn(cmd, a, columnID, app.MatchPrefix, func(id string) (err error) {
+		column, err = a.Client.GetColumn(cmd.Context(), id)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching column: %w", err)
 	}
 
-	return ui.DisplayColumn(cmd.OutOrStdout(), column)
+	return printResult(cmd, column, func() error {
+		return ui.DisplayColumn(cmd.OutOrStdout(), column)
+	})
 }
 
 func init() {
+	columnShowCmd.ValidArgsFunction = completeArgs(completeColumns)
 	columnCmd.AddCommand(columnShowCmd)
 }
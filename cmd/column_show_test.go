@@ -64,7 +64,7 @@ func TestColumnShowCommandNotFound(t *testing.T) {
 	cmd := columnShowCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
-	err := handleShowColumnDetails(cmd, "nonexistent-col")
+	err := handleShowColumnDetails(cmd, "col-404")
 	if err == nil {
 		t.Errorf("expected error for column not found")
 	}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -10,14 +9,12 @@ import (
 )
 
 var columnUpdateCmd = &cobra.Command{
-	Use:   "update <column_id>",
+	Use:   "update <column>",
 	Short: "Update a column",
 	Long:  `Update column settings such as name and color`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateColumn(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateColumn(cmd, args[0])
 	},
 }
 
@@ -29,7 +26,7 @@ func handleUpdateColumn(cmd *cobra.Command, columnID string) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	// Build payload only with flags that were explicitly set
@@ -50,18 +47,29 @@ func handleUpdateColumn(cmd *cobra.Command, columnID string) error {
 		payload.Color = &color
 	}
 
-	err := a.Client.UpdateColumn(context.Background(), columnID, payload)
+	columnID, err := forColumn(cmd, a, columnID, app.MatchPrefix, func(id string) error {
+		return a.Client.UpdateColumn(cmd.Context(), id, payload)
+	})
 	if err != nil {
 		return fmt.Errorf("updating column: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Column '%s' updated successfully\n", columnID)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		column, err := a.Client.GetColumn(cmd.Context(), columnID)
+		if err != nil {
+			return nil, fmt.Errorf("fetching column: %w", err)
+		}
+		return column, nil
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Column '%s' updated successfully\n", columnID)
+		return nil
+	})
 }
 
 func init() {
 	columnUpdateCmd.Flags().StringP("name", "n", "", "Column name")
 	columnUpdateCmd.Flags().String("color", "", fmt.Sprintf("Column color (optional). Available: %s", getAvailableColors()))
 
+	columnUpdateCmd.ValidArgsFunction = completeArgs(completeColumns)
 	columnCmd.AddCommand(columnUpdateCmd)
 }
@@ -149,7 +149,7 @@ func TestColumnUpdateCommandNotFound(t *testing.T) {
 	cmd.SetContext(testApp.ToContext(context.Background()))
 	cmd.ParseFlags([]string{"--name", "Updated Column"})
 
-	err := handleUpdateColumn(cmd, "nonexistent-col")
+	err := handleUpdateColumn(cmd, "col-404")
 	if err == nil {
 		t.Errorf("expected error for column not found")
 	}
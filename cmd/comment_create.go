@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,38 +12,39 @@ var commentCreateCmd = &cobra.Command{
 	Short: "Create a new comment",
 	Long:  `Create a new comment on a card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateComment(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateComment(cmd, args[0])
 	},
 }
 
 func handleCreateComment(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	body, _ := cmd.Flags().GetString("body")
 
-	_, err = a.Client.CreateCardComment(context.Background(), cardNum, body)
+	comment, err := a.Client.CreateCardComment(cmd.Context(), cardNum, body)
 	if err != nil {
 		return fmt.Errorf("creating comment: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Comment created successfully\n")
-	return nil
+	return printResult(cmd, comment, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Comment created successfully\n")
+		return nil
+	})
 }
 
 func init() {
 	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required)")
 	commentCreateCmd.MarkFlagRequired("body")
 
+	commentCreateCmd.ValidArgsFunction = completeArgs(completeCards)
 	commentCmd.AddCommand(commentCreateCmd)
 }
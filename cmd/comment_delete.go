@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var commentDeleteCmd = &cobra.Command{
 	Short: "Delete a comment",
 	Long:  `Delete a comment from a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteComment(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteComment(cmd, args[0], args[1])
 	},
 }
 
 func handleDeleteComment(cmd *cobra.Command, cardNumber, commentID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.DeleteCardComment(context.Background(), cardNum, commentID)
+	err = a.Client.DeleteCardComment(cmd.Context(), cardNum, commentID)
 	if err != nil {
 		return fmt.Errorf("deleting comment: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleDeleteComment(cmd *cobra.Command, cardNumber, commentID string) error
 }
 
 func init() {
+	commentDeleteCmd.ValidArgsFunction = completeArgs(completeCards)
 	commentCmd.AddCommand(commentDeleteCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
@@ -15,32 +13,33 @@ var commentShowCmd = &cobra.Command{
 	Short: "Show a specific comment",
 	Long:  `Display details of a specific comment on a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowComment(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowComment(cmd, args[0], args[1])
 	},
 }
 
 func handleShowComment(cmd *cobra.Command, cardNumber, commentID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	comment, err := a.Client.GetCardComment(context.Background(), cardNum, commentID)
+	comment, err := a.Client.GetCardComment(cmd.Context(), cardNum, commentID)
 	if err != nil {
 		return fmt.Errorf("fetching comment: %w", err)
 	}
 
-	return ui.DisplayComment(comment)
+	return printResult(cmd, comment, func() error {
+		return ui.DisplayComment(comment)
+	})
 }
 
 func init() {
+	commentShowCmd.ValidArgsFunction = completeArgs(completeCards)
 	commentCmd.AddCommand(commentShowCmd)
 }
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// completionCardLimit caps how many cards are offered, the most recently
+// active first.
+const completionCardLimit = 100
+
+// completeArgs completes each positional argument with the function at its
+// position, and offers nothing past them.
+func completeArgs(fns ...cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) >= len(fns) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fns[len(args)](cmd, args, toComplete)
+	}
+}
+
+// completeWith returns a completion function offering what list finds. The
+// app it's given answers from the cache for a few minutes, so completion
+// stays quick and keeps working briefly offline.
+func completeWith(list func(ctx context.Context, a *app.App, toComplete string) ([]cobra.Completion, error)) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		a := completionApp(cmd)
+		if a == nil || a.Client == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions, err := list(commandContext(cmd), a, toComplete)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completionApp returns the app to look completions up with: the command's,
+// if it has one, or else one set up for completion from the global flags.
+// Cobra doesn't run the root's PersistentPreRunE when completing.
+func completionApp(cmd *cobra.Command) *app.App {
+	if a := app.FromContext(commandContext(cmd)); a != nil {
+		return a
+	}
+	opts, err := appOptions(cmd)
+	if err != nil {
+		return nil
+	}
+	a, err := app.New(Version, append(opts, app.ForCompletion())...)
+	if err != nil {
+		return nil
+	}
+	return a
+}
+
+func commandContext(cmd *cobra.Command) context.Context {
+	if ctx := cmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// completeCards offers the numbers of the selected board's cards, or of all
+// cards when no board is selected, described by their titles. They're
+// written #42 once a # is typed.
+var completeCards = completeWith(func(ctx context.Context, a *app.App, toComplete string) ([]cobra.Completion, error) {
+	filters := &fizzy.CardFilters{Limit: completionCardLimit}
+	if board := a.SelectedBoard(); board != "" {
+		filters.BoardIDs = []string{board}
+	}
+	cards, err := a.Client.GetCards(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefix string
+	if strings.HasPrefix(toComplete, "#") {
+		prefix = "#"
+	}
+	completions := make([]cobra.Completion, len(cards))
+	for i, c := range cards {
+		completions[i] = cobra.CompletionWithDesc(prefix+strconv.Itoa(c.Number), c.Title)
+	}
+	return completions, nil
+})
+
+// completeBoards offers board names, described by their IDs, for arguments
+// and flags that take either.
+var completeBoards = completeWith(func(ctx context.Context, a *app.App, _ string) ([]cobra.Completion, error) {
+	boards, err := a.Client.GetBoards(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]cobra.Completion, len(boards))
+	for i, b := range boards {
+		completions[i] = cobra.CompletionWithDesc(b.Name, b.ID)
+	}
+	return completions, nil
+})
+
+// completeBoardIDs offers board IDs, described by their names, for flags
+// that only take IDs.
+var completeBoardIDs = completeWith(func(ctx context.Context, a *app.App, _ string) ([]cobra.Completion, error) {
+	boards, err := a.Client.GetBoards(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]cobra.Completion, len(boards))
+	for i, b := range boards {
+		completions[i] = cobra.CompletionWithDesc(b.ID, b.Name)
+	}
+	return completions, nil
+})
+
+// completeColumns offers the names of the selected board's columns,
+// described by their IDs.
+var completeColumns = completeWith(func(ctx context.Context, a *app.App, _ string) ([]cobra.Completion, error) {
+	columns, err := a.Client.GetColumns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]cobra.Completion, len(columns))
+	for i, c := range columns {
+		completions[i] = cobra.CompletionWithDesc(c.Name, c.ID)
+	}
+	return completions, nil
+})
+
+// completeUsers offers "me" and user names, described by their email
+// addresses.
+var completeUsers = completeWith(func(ctx context.Context, a *app.App, _ string) ([]cobra.Completion, error) {
+	users, err := a.Client.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	completions := []cobra.Completion{cobra.CompletionWithDesc("me", "The signed in user")}
+	for _, u := range users {
+		completions = append(completions, cobra.CompletionWithDesc(u.Name, u.Email))
+	}
+	return completions, nil
+})
+
+// completeTags offers tag titles, written #title once a # is typed.
+var completeTags = completeWith(func(ctx context.Context, a *app.App, toComplete string) ([]cobra.Completion, error) {
+	tags, err := a.Client.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefix string
+	if strings.HasPrefix(toComplete, "#") {
+		prefix = "#"
+	}
+	completions := make([]cobra.Completion, len(tags))
+	for i, t := range tags {
+		completions[i] = prefix + t.Title
+	}
+	return completions, nil
+})
+
+// completeTagIDs offers tag IDs, described by their titles, for flags that
+// only take IDs.
+var completeTagIDs = completeWith(func(ctx context.Context, a *app.App, _ string) ([]cobra.Completion, error) {
+	tags, err := a.Client.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]cobra.Completion, len(tags))
+	for i, t := range tags {
+		completions[i] = cobra.CompletionWithDesc(t.ID, "#"+t.Title)
+	}
+	return completions, nil
+})
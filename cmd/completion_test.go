@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func completionServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test-account/cards":
+			if got := r.URL.Query()["board_ids[]"]; !slices.Equal(got, []string{"board-1"}) {
+				t.Errorf("expected cards of the selected board, got board_ids[]=%v", got)
+			}
+			json.NewEncoder(w).Encode([]fizzy.Card{{Number: 42, Title: "Fix login"}, {Number: 7, Title: "Write docs"}})
+		case "/test-account/boards/board-1/columns":
+			json.NewEncoder(w).Encode([]fizzy.Column{{ID: "col-1", Name: "Backlog"}, {ID: "col-2", Name: "In Progress"}})
+		case "/test-account/users":
+			json.NewEncoder(w).Encode([]fizzy.User{{ID: "user-1", Name: "Jane Doe", Email: "jane@example.com"}})
+		case "/test-account/tags":
+			json.NewEncoder(w).Encode([]fizzy.Tag{{ID: "tag-1", Title: "bug"}})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func completionCmd(t *testing.T) *cobra.Command {
+	t.Helper()
+	server := completionServer(t)
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "board-1", "test-token"),
+		Config: &config.Config{SelectedBoard: "board-1"},
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	return cmd
+}
+
+func TestCompleteCards(t *testing.T) {
+	cmd := completionCmd(t)
+
+	tests := []struct {
+		toComplete string
+		want       []cobra.Completion
+	}{
+		{"", []cobra.Completion{"42\tFix login", "7\tWrite docs"}},
+		{"#", []cobra.Completion{"#42\tFix login", "#7\tWrite docs"}},
+	}
+	for _, tt := range tests {
+		got, directive := completeCards(cmd, nil, tt.toComplete)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("completeCards(%q) = %q, want %q", tt.toComplete, got, tt.want)
+		}
+		if directive != cobra.ShellCompDirectiveNoFileComp {
+			t.Errorf("expected no file completion, got %v", directive)
+		}
+	}
+}
+
+func TestCompleteArgs(t *testing.T) {
+	cmd := completionCmd(t)
+	complete := completeArgs(completeCards, completeColumns)
+
+	got, _ := complete(cmd, []string{"42"}, "")
+	want := []cobra.Completion{"Backlog\tcol-1", "In Progress\tcol-2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected columns for the second argument, got %q", got)
+	}
+
+	got, directive := complete(cmd, []string{"42", "Backlog"}, "")
+	if len(got) != 0 || directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("expected nothing past the last argument, got %q (%v)", got, directive)
+	}
+}
+
+func TestCompleteUsersAndTags(t *testing.T) {
+	cmd := completionCmd(t)
+
+	got, _ := completeUsers(cmd, nil, "")
+	want := []cobra.Completion{"me\tThe signed in user", "Jane Doe\tjane@example.com"}
+	if !slices.Equal(got, want) {
+		t.Errorf("completeUsers = %q, want %q", got, want)
+	}
+
+	got, _ = completeTags(cmd, nil, "#")
+	if !slices.Equal(got, []cobra.Completion{"#bug"}) {
+		t.Errorf("completeTags(#) = %q, want [#bug]", got)
+	}
+	got, _ = completeTagIDs(cmd, nil, "")
+	if !slices.Equal(got, []cobra.Completion{"tag-1\t#bug"}) {
+		t.Errorf("completeTagIDs = %q, want [tag-1\t#bug]", got)
+	}
+}
+
+func TestCompleteAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if got, directive := completeBoards(cmd, nil, ""); len(got) != 0 || directive != cobra.ShellCompDirectiveError {
+		t.Errorf("expected an error directive, got %q (%v)", got, directive)
+	}
+}
+
+func TestCompletionCacheMaxAge(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-1", Name: "Roadmap"}})
+	}))
+
+	cache, err := app.NewCacheTransport(nil, "/test-account", "test-token")
+	if err != nil {
+		t.Fatalf("NewCacheTransport failed: %v", err)
+	}
+	cache.MaxAge = time.Minute
+	client := &http.Client{Transport: cache}
+
+	get := func() (string, error) {
+		resp, err := client.Get(server.URL + "/test-account/boards")
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	first, err := get()
+	if err != nil {
+		t.Fatalf("first request failed: %v", err)
+	}
+	// Offline now: the cached response is still fresh.
+	server.Close()
+	second, err := get()
+	if err != nil {
+		t.Fatalf("expected the cached response offline, got %v", err)
+	}
+	if second != first || requests != 1 {
+		t.Errorf("expected one request and the same response, got %d requests and %q", requests, second)
+	}
+
+	cache.MaxAge = 0
+	if _, err := get(); err == nil {
+		t.Errorf("expected responses without an ETag to need the API without MaxAge")
+	}
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+)
+
+// Exit codes returned by the CLI. API failures map from the HTTP status of
+// the response so scripts can tell them apart without parsing messages.
+const (
+	exitOK         = 0
+	exitError      = 1 // any other failure
+	exitUsage      = 2 // invalid command, arguments or flags
+	exitAuth       = 3 // missing or rejected access token (401)
+	exitPermission = 4 // not allowed to perform the action (403)
+	exitNotFound   = 5 // resource does not exist (404)
+	exitValidation = 6 // request rejected by the API (422)
+	exitRateLimit  = 7 // too many requests (429)
+	exitServer     = 8 // API server error (5xx)
+
+	exitInterrupted = 130 // cancelled with Ctrl-C, as shells report SIGINT
+)
+
+// errNoClient is returned when no access token is configured.
+var errNoClient = errors.New("API client not available")
+
+// usageError marks errors caused by how the command was invoked.
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string { return e.err.Error() }
+func (e *usageError) Unwrap() error { return e.err }
+
+// statusError is an error a command reworded from an API error, keeping the
+// HTTP status of the response it stands for.
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e *statusError) Error() string { return e.msg }
+
+// exitCode maps err to the code the process exits with.
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		return exitUsage
+	}
+	if errors.Is(err, errNoClient) {
+		return exitAuth
+	}
+	var resolveErr *app.ResolveError
+	if errors.As(err, &resolveErr) {
+		if resolveErr.Ambiguous {
+			return exitUsage
+		}
+		return exitNotFound
+	}
+
+	status := app.StatusCode(err)
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		status = statusErr.status
+	}
+
+	switch {
+	case status == http.StatusUnauthorized:
+		return exitAuth
+	case status == http.StatusForbidden:
+		return exitPermission
+	case status == http.StatusNotFound:
+		return exitNotFound
+	case status == http.StatusUnprocessableEntity:
+		return exitValidation
+	case status == http.StatusTooManyRequests:
+		return exitRateLimit
+	case status >= http.StatusInternalServerError:
+		return exitServer
+	}
+	return exitError
+}
+
+// validationError is a 422 response whose field errors are reported against
+// the flags that set those fields.
+type validationError struct {
+	op     string
+	fields []fieldError
+	apiErr *app.APIError
+}
+
+// fieldError holds the messages the API returned for one field. Flag is the
+// flag the field was set with, or the API field name when no flag maps to it.
+type fieldError struct {
+	Flag     string
+	Messages []string
+}
+
+func (e *validationError) Error() string {
+	parts := make([]string, len(e.fields))
+	for i, f := range e.fields {
+		msg := strings.Join(f.Messages, ", ")
+		if f.Flag != "" {
+			msg = f.Flag + ": " + msg
+		}
+		parts[i] = msg
+	}
+	return fmt.Sprintf("%s: %s", e.op, strings.Join(parts, "; "))
+}
+
+func (e *validationError) Unwrap() error { return e.apiErr }
+
+// wrapAPIError wraps err from the API call described by op. Validation
+// failures that come with field details, such as
+//
+//	{"avatar": ["must be a JPEG, PNG, GIF, or WebP image"]}
+//
+// become a validationError naming the flags in flags, which maps API field
+// names to flag names. Any other error is wrapped as is.
+func wrapAPIError(op string, err error, flags map[string]string) error {
+	apiErr, ok := app.AsAPIError(err)
+	if !ok || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	fields := parseFieldErrors(apiErr.Body, flags)
+	if len(fields) == 0 {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return &validationError{op: op, fields: fields, apiErr: apiErr}
+}
+
+func parseFieldErrors(body string, flags map[string]string) []fieldError {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return nil
+	}
+
+	var fields []fieldError
+	for name, value := range raw {
+		var messages []string
+		if err := json.Unmarshal(value, &messages); err != nil {
+			var message string
+			if err := json.Unmarshal(value, &message); err != nil {
+				continue
+			}
+			messages = []string{message}
+		}
+		if len(messages) == 0 {
+			continue
+		}
+
+		flag := name
+		if f, ok := flags[name]; ok {
+			flag = "--" + f
+		} else if name == "base" {
+			// Errors on the record as a whole belong to no field.
+			flag = ""
+		}
+		fields = append(fields, fieldError{Flag: flag, Messages: messages})
+	}
+
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Flag < fields[j].Flag })
+	return fields
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+)
+
+func TestExitCodeFromAPIStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   int
+	}{
+		{http.StatusUnauthorized, exitAuth},
+		{http.StatusForbidden, exitPermission},
+		{http.StatusNotFound, exitNotFound},
+		{http.StatusUnprocessableEntity, exitValidation},
+		{http.StatusTooManyRequests, exitRateLimit},
+		{http.StatusInternalServerError, exitServer},
+		{http.StatusBadGateway, exitServer},
+		{http.StatusBadRequest, exitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte("failed"))
+			}))
+			defer server.Close()
+
+			client := testutil.NewTestClient(server.URL, "", "board-123", "test-token")
+			testApp := &app.App{
+				Client: client,
+				Config: &config.Config{SelectedBoard: "board-123"},
+			}
+
+			cmd := boardShowCmd
+			cmd.SetContext(testApp.ToContext(context.Background()))
+
+			err := handleShowBoardDetails(cmd, "board-123")
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := exitCode(err); got != tt.want {
+				t.Errorf("expected exit code %d, got %d (error: %v)", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestExitCodeOtherErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, exitOK},
+		{"generic", errors.New("boom"), exitError},
+		{"usage", &usageError{errors.New("unknown flag: --nope")}, exitUsage},
+		{"no client", fmt.Errorf("wrapped: %w", errNoClient), exitAuth},
+		{"reworded status", &statusError{http.StatusNotFound, "notification not found"}, exitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("expected exit code %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWrapAPIError(t *testing.T) {
+	flags := map[string]string{"title": "title", "created_at": "created-at"}
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			"field errors",
+			errors.New(`unexpected status code 422: {"title": ["can't be blank"], "created_at": ["is invalid", "is in the future"]}`),
+			"creating card: --created-at: is invalid, is in the future; --title: can't be blank",
+		},
+		{
+			"unmapped field",
+			errors.New(`unexpected status code 422: {"board": "must exist"}`),
+			"creating card: board: must exist",
+		},
+		{
+			"base error",
+			errors.New(`unexpected status code 422: {"base": ["Card limit reached"]}`),
+			"creating card: Card limit reached",
+		},
+		{
+			"no details",
+			errors.New("unexpected status code 422: Unprocessable Entity"),
+			"creating card: unexpected status code 422: Unprocessable Entity",
+		},
+		{
+			"other status",
+			errors.New(`unexpected status code 400: {"title": ["can't be blank"]}`),
+			`creating card: unexpected status code 400: {"title": ["can't be blank"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapAPIError("creating card", tt.err, flags)
+			if err.Error() != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
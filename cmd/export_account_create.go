@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,25 +12,25 @@ var exportAccountCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Start an account export",
 	Long:  `Start an account export job. Poll its status with 'export account show <id>'.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateAccountExport(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateAccountExport(cmd)
 	},
 }
 
 func handleCreateAccountExport(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	export, err := a.Client.CreateAccountExport(context.Background())
+	export, err := a.Client.CreateAccountExport(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("creating account export: %w", err)
 	}
 
-	return ui.DisplayExport(cmd.OutOrStdout(), export)
+	return printResult(cmd, export, func() error {
+		return ui.DisplayExport(cmd.OutOrStdout(), export)
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -14,25 +13,25 @@ var exportAccountShowCmd = &cobra.Command{
 	Short: "Show account export status",
 	Long:  `Retrieve and display the status of an account export`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowAccountExport(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowAccountExport(cmd, args[0])
 	},
 }
 
 func handleShowAccountExport(cmd *cobra.Command, exportID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	export, err := a.Client.GetAccountExport(context.Background(), exportID)
+	export, err := a.Client.GetAccountExport(cmd.Context(), exportID)
 	if err != nil {
 		return fmt.Errorf("fetching account export: %w", err)
 	}
 
-	return ui.DisplayExport(cmd.OutOrStdout(), export)
+	return printResult(cmd, export, func() error {
+		return ui.DisplayExport(cmd.OutOrStdout(), export)
+	})
 }
 
 func init() {
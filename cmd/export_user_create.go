@@ -1,40 +1,45 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var exportUserCreateCmd = &cobra.Command{
-	Use:   "create <user_id>",
+	Use:   "create <user>",
 	Short: "Start a user data export",
 	Long:  `Start a personal data export for the given user. You can only export data for your own user record.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateUserDataExport(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateUserDataExport(cmd, args[0])
 	},
 }
 
 func handleCreateUserDataExport(cmd *cobra.Command, userID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	export, err := a.Client.CreateUserDataExport(context.Background(), userID)
+	var export *fizzy.Export
+	_, err := forUser(cmd, a, userID, app.MatchPrefix, func(id string) (err error) {
+		export, err = a.Client.CreateUserDataExport(cmd.Context(), id)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("creating user data export: %w", err)
 	}
 
-	return ui.DisplayExport(cmd.OutOrStdout(), export)
+	return printResult(cmd, export, func() error {
+		return ui.DisplayExport(cmd.OutOrStdout(), export)
+	})
 }
 
 func init() {
+	exportUserCreateCmd.ValidArgsFunction = completeArgs(completeUsers)
 	exportUserCmd.AddCommand(exportUserCreateCmd)
 }
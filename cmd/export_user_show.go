@@ -1,40 +1,45 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var exportUserShowCmd = &cobra.Command{
-	Use:   "show <user_id> <export_id>",
+	Use:   "show <user> <export_id>",
 	Short: "Show user data export status",
 	Long:  `Retrieve and display the status of a user data export`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowUserDataExport(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowUserDataExport(cmd, args[0], args[1])
 	},
 }
 
 func handleShowUserDataExport(cmd *cobra.Command, userID, exportID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	export, err := a.Client.GetUserDataExport(context.Background(), userID, exportID)
+	var export *fizzy.Export
+	_, err := forUser(cmd, a, userID, app.MatchPrefix, func(id string) (err error) {
+		export, err = a.Client.GetUserDataExport(cmd.Context(), id, exportID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching user data export: %w", err)
 	}
 
-	return ui.DisplayExport(cmd.OutOrStdout(), export)
+	return printResult(cmd, export, func() error {
+		return ui.DisplayExport(cmd.OutOrStdout(), export)
+	})
 }
 
 func init() {
+	exportUserShowCmd.ValidArgsFunction = completeArgs(completeUsers)
 	exportUserCmd.AddCommand(exportUserShowCmd)
 }
@@ -1,9 +1,11 @@
 package cmd
 
 import (
-	"context"
+	"bufio"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,29 +15,122 @@ import (
 
 var loginCmd = &cobra.Command{
 	Use:   "login",
-	Short: "Prints instructions on how to authenticate with the Fizzy API",
-	Long:  `Prints intructions on how to authenticate with the Fizzy API`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleLogin(cmd); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		}
+	Short: "Authenticate with the Fizzy API",
+	Long: `Authenticate with the Fizzy API.
+
+With --email, signs in with a code emailed to you, then creates a personal
+access token and saves it, so no token needs to be exported. With
+--with-token, saves a token read from stdin. Without either, verifies the
+saved token or the one in FIZZY_ACCESS_TOKEN, or prints instructions to
+create one.
+
+Tokens are saved to ~/.config/fizzy-cli/credentials.json, readable only by
+you, or to the credential helper set as credential_helper in the config.
+With --encrypt, or when FIZZY_PASSPHRASE is set, the token is encrypted
+with a passphrase.
+
+Examples:
+  fizzy login --email you@example.com
+  pass show fizzy | fizzy login --with-token`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleLogin(cmd)
 	},
 }
 
 func handleLogin(cmd *cobra.Command) error {
-	token, isSet := os.LookupEnv("FIZZY_ACCESS_TOKEN")
-	if !isSet || token == "" {
+	if email, _ := cmd.Flags().GetString("email"); email != "" {
+		return handleEmailLogin(cmd, email)
+	}
+
+	a := app.FromContext(cmd.Context())
+	if withToken, _ := cmd.Flags().GetBool("with-token"); withToken {
+		return handleTokenLogin(cmd, a)
+	}
+
+	token := os.Getenv("FIZZY_ACCESS_TOKEN")
+	if token == "" && a != nil {
+		if a.AuthErr != nil {
+			return a.AuthErr
+		}
+		token = a.Token
+	}
+	if token == "" {
 		return printAuthInstructions(cmd)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Authenticated with access token: %s\n", token[:6]+"...")
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Authenticated with access token: %s\n", app.RedactToken(token))
 
-	a := app.FromContext(cmd.Context())
-	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+	if a == nil {
+		return errNoClient
+	}
+	client := a.Client
+	if client == nil {
+		var err error
+		if client, err = identityClient(a, token); err != nil {
+			return err
+		}
 	}
 
-	identity, err := a.Client.GetMyIdentity(context.Background())
+	return selectIdentityAccount(cmd, a, client)
+}
+
+// handleTokenLogin saves an access token read from stdin, once it's known to
+// work.
+func handleTokenLogin(cmd *cobra.Command, a *app.App) error {
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := identityClient(a, token)
+	if err != nil {
+		return err
+	}
+	// The token could have either permission.
+	a.Config.SetTokenPermission("")
+	if err := selectIdentityAccount(cmd, a, client); err != nil {
+		return err
+	}
+
+	if err := storeToken(cmd, a, token); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Saved access token %s to %s\n", app.RedactToken(token), a.Credentials.Location())
+	return nil
+}
+
+// readToken reads an access token from the first line of stdin.
+func readToken(cmd *cobra.Command) (string, error) {
+	token, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && token == "" {
+		return "", fmt.Errorf("reading token: %w", err)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("no token on stdin")
+	}
+	return token, nil
+}
+
+// identityClient returns a client for token to look up its identity with,
+// which isn't scoped to an account, before one is selected.
+func identityClient(a *app.App, token string) (*fizzy.Client, error) {
+	client, err := a.NewClient("/", token)
+	if err != nil {
+		return nil, fmt.Errorf("creating API client: %w", err)
+	}
+	return client, nil
+}
+
+// selectIdentityAccount lets the user pick one of the token's accounts and
+// saves it as the selected account.
+func selectIdentityAccount(cmd *cobra.Command, a *app.App, client *fizzy.Client) error {
+	identity, err := client.GetMyIdentity(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching identity: %w", err)
 	}
@@ -57,25 +152,165 @@ func handleLogin(cmd *cobra.Command) error {
 	return nil
 }
 
+// handleEmailLogin signs in with a magic link code and saves a newly created
+// access token, so the session itself is only used to bootstrap.
+func handleEmailLogin(cmd *cobra.Command, email string) error {
+	permission, _ := cmd.Flags().GetString("permission")
+	if err := validateOneOf("permission", permission, tokenPermissions); err != nil {
+		return err
+	}
+	description, _ := cmd.Flags().GetString("description")
+
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+	session := app.NewSessionClient(a.BaseURL, a.HTTPClient)
+
+	pendingToken, err := session.RequestMagicLink(cmd.Context(), email)
+	if err != nil {
+		return fmt.Errorf("requesting sign-in code: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Sign-in code sent to %s\n", email)
+	fmt.Fprintf(cmd.OutOrStdout(), "Enter the 6-character code from the email: ")
+	code, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && code == "" {
+		return fmt.Errorf("reading code: %w", err)
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return fmt.Errorf("no code entered")
+	}
+
+	sessionToken, err := session.SubmitMagicLinkCode(cmd.Context(), pendingToken, code)
+	if err != nil {
+		return fmt.Errorf("submitting sign-in code: %w", err)
+	}
+	// The session is only needed until the access token exists.
+	defer session.DeleteSession(cmd.Context(), sessionToken)
+
+	identity, err := session.GetMyIdentity(cmd.Context(), sessionToken)
+	if err != nil {
+		return fmt.Errorf("fetching identity: %w", err)
+	}
+	if len(identity.Accounts) == 0 {
+		return fmt.Errorf("no accounts found for %s", email)
+	}
+
+	selected, err := chooseAccount(cmd, identity.Accounts)
+	if err != nil {
+		return err
+	}
+
+	token, err := session.CreateAccessToken(cmd.Context(), sessionToken, selected.Slug, fizzy.CreateAccessTokenPayload{
+		Description: description,
+		Permission:  permission,
+	})
+	if err != nil {
+		return fmt.Errorf("creating access token: %w", err)
+	}
+
+	if err := storeToken(cmd, a, token.Token); err != nil {
+		return err
+	}
+	a.Config.SelectedAccount = selected.Slug
+	a.Config.CurrentUserID = selected.User.ID
+	a.Config.SetTokenPermission(token.Permission)
+	if err := a.Config.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "\n✓ Logged in as %s (%s)\n", selected.User.Name, selected.User.Email)
+	fmt.Fprintf(cmd.OutOrStdout(), "Selected account: %s (%s)\n", selected.Name, selected.Slug)
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Created %s access token %s and saved it to %s\n", token.Permission, app.RedactToken(token.Token), a.Credentials.Location())
+	return nil
+}
+
+// storeToken saves token to the credential store, encrypted with a new
+// passphrase if --encrypt is set.
+func storeToken(cmd *cobra.Command, a *app.App, token string) error {
+	if encrypt, _ := cmd.Flags().GetBool("encrypt"); encrypt {
+		a.Credentials.Encrypt = true
+		if os.Getenv("FIZZY_PASSPHRASE") == "" {
+			a.Credentials.Passphrase = newPassphrase
+		}
+	}
+	if err := a.Credentials.Store(a.Config.Credential(), token); err != nil {
+		return fmt.Errorf("saving access token: %w", err)
+	}
+	return nil
+}
+
+// newPassphrase asks for a passphrase to encrypt credentials with, twice.
+func newPassphrase() (string, error) {
+	passphrase, err := ui.PromptPassword("New passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase must not be empty")
+	}
+	confirmed, err := ui.PromptPassword("Confirm passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if confirmed != passphrase {
+		return "", fmt.Errorf("passphrases don't match")
+	}
+	return passphrase, nil
+}
+
+// chooseAccount returns the account given with --account, or the only one,
+// or else asks which one to use.
 func chooseAccount(cmd *cobra.Command, accounts []fizzy.Account) (fizzy.Account, error) {
+	if a := app.FromContext(cmd.Context()); a != nil && a.Account != "" {
+		for _, account := range accounts {
+			if account.Slug == a.Account {
+				return account, nil
+			}
+		}
+		return fizzy.Account{}, fmt.Errorf("account '%s' not found for this access token", a.Account)
+	}
 	if len(accounts) == 1 {
 		selected := accounts[0]
 		return selected, nil
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "\nAvailable accounts:\n")
-	return ui.SelectAccount(accounts)
+	items := make([]ui.PickerItem, len(accounts))
+	for i, account := range accounts {
+		items[i] = ui.PickerItem{Label: account.Name, Detail: account.Slug}
+	}
+	i, err := ui.Pick("Select an account:", items)
+	if errors.Is(err, ui.ErrNotTerminal) {
+		return fizzy.Account{}, &usageError{fmt.Errorf("this access token has %d accounts: pass --account to choose one", len(accounts))}
+	}
+	if err != nil {
+		return fizzy.Account{}, fmt.Errorf("choosing account: %w", err)
+	}
+	return accounts[i], nil
 }
 
 func printAuthInstructions(cmd *cobra.Command) error {
 	fmt.Fprintf(cmd.OutOrStdout(), "To authenticate with Fizzy's API you need an access token.\n")
-	fmt.Fprintf(cmd.OutOrStdout(), "\nGo to https://app.fizzy.do/<account_slug>/my/access_tokens and follow the instructions...\n")
+	baseURL := fizzy.DefaultBaseURL
+	if a := app.FromContext(cmd.Context()); a != nil && a.BaseURL != "" {
+		baseURL = a.BaseURL
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "\nGo to %s/<account_slug>/my/access_tokens and follow the instructions...\n", baseURL)
 	fmt.Fprintf(cmd.OutOrStdout(), "(Replace <account_slug> with your account slug)\n")
-	fmt.Fprintf(cmd.OutOrStdout(), "\nThen export it as an environment variable in your shell, with the name FIZZY_ACCESS_TOKEN\n")
-	fmt.Fprintf(cmd.OutOrStdout(), "And re-run this command.\n")
+	fmt.Fprintf(cmd.OutOrStdout(), "\nThen save it by piping it to this command: echo <your_token> | fizzy login --with-token\n")
+	fmt.Fprintf(cmd.OutOrStdout(), "(Or export it as FIZZY_ACCESS_TOKEN in your shell and re-run this command.)\n")
+	fmt.Fprintf(cmd.OutOrStdout(), "\nOr sign in with a code sent to your email instead: fizzy login --email <your_email>\n")
 	return nil
 }
 
 func init() {
+	loginCmd.Flags().StringP("email", "e", "", "Sign in with a code sent to this email address")
+	loginCmd.Flags().StringP("permission", "p", "write", "Permission of the created access token: read or write")
+	loginCmd.Flags().StringP("description", "d", "Fizzy CLI", "Description of the created access token")
+	loginCmd.Flags().Bool("with-token", false, "Read an access token from stdin and save it")
+	loginCmd.Flags().Bool("encrypt", false, "Encrypt the saved token with a passphrase (env: FIZZY_PASSPHRASE)")
+	loginCmd.MarkFlagsMutuallyExclusive("email", "with-token")
 	rootCmd.AddCommand(loginCmd)
 }
@@ -1,17 +1,20 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/config"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestLoginCommand(t *testing.T) {
@@ -96,3 +99,308 @@ func TestLoginCommandWithoutToken(t *testing.T) {
 		t.Errorf("expected no error when token is missing, got %v", err)
 	}
 }
+
+func TestLoginCommandInstructionsFollowBaseURL(t *testing.T) {
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+
+	testApp := &app.App{Config: &config.Config{}, BaseURL: "https://fizzy.example.com"}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleLogin(cmd); err != nil {
+		t.Fatalf("handleLogin failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "https://fizzy.example.com/<account_slug>/my/access_tokens") {
+		t.Errorf("expected the self-hosted token page, got:\n%s", out.String())
+	}
+}
+
+func newEmailLoginCmd(email string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("email", email, "")
+	cmd.Flags().String("permission", "write", "")
+	cmd.Flags().String("description", "Fizzy CLI", "")
+	return cmd
+}
+
+func TestLoginCommandWithEmail(t *testing.T) {
+	var sessionDeleted bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("expected no Authorization header, got %s", r.Header.Get("Authorization"))
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/session":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["email_address"] != "test@example.com" {
+				t.Errorf("expected email_address test@example.com, got %s", body["email_address"])
+			}
+			http.SetCookie(w, &http.Cookie{Name: "pending_authentication_token", Value: "pending-123"})
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]string{"pending_authentication_token": "pending-123"})
+		case r.Method == http.MethodPost && r.URL.Path == "/session/magic_link":
+			if c, err := r.Cookie("pending_authentication_token"); err != nil || c.Value != "pending-123" {
+				t.Errorf("expected pending_authentication_token cookie, got %v", c)
+			}
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["code"] != "ABC123" {
+				t.Errorf("expected code ABC123, got %s", body["code"])
+			}
+			json.NewEncoder(w).Encode(map[string]string{"session_token": "session-123"})
+		case r.Method == http.MethodGet && r.URL.Path == "/my/identity":
+			if c, err := r.Cookie("session_token"); err != nil || c.Value != "session-123" {
+				t.Errorf("expected session_token cookie, got %v", c)
+			}
+			json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{
+				Accounts: []fizzy.Account{{
+					Name: "Test Account",
+					Slug: "/123456",
+					User: fizzy.User{ID: "user-123", Name: "Test User", Email: "test@example.com"},
+				}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/123456/my/access_tokens":
+			if c, err := r.Cookie("session_token"); err != nil || c.Value != "session-123" {
+				t.Errorf("expected session_token cookie, got %v", c)
+			}
+			var body map[string]fizzy.CreateAccessTokenPayload
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["access_token"].Permission != "write" {
+				t.Errorf("expected write permission, got %s", body["access_token"].Permission)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(fizzy.PersonalAccessToken{Token: "new-access-token", Description: "Fizzy CLI", Permission: "write"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/session":
+			sessionDeleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+
+	cfg := &config.Config{}
+	testApp := &app.App{
+		Config:      cfg,
+		BaseURL:     server.URL,
+		HTTPClient:  server.Client(),
+		Credentials: config.NewCredentialStore(cfg, "app.fizzy.do"),
+	}
+
+	cmd := newEmailLoginCmd("test@example.com")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetIn(strings.NewReader("ABC123\n"))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleLogin(cmd); err != nil {
+		t.Fatalf("handleLogin failed: %v", err)
+	}
+
+	if cfg.SelectedAccount != "/123456" {
+		t.Errorf("expected selected account /123456, got %q", cfg.SelectedAccount)
+	}
+	if cfg.CurrentUserID != "user-123" {
+		t.Errorf("expected current user user-123, got %q", cfg.CurrentUserID)
+	}
+	if !sessionDeleted {
+		t.Error("expected the bootstrap session to be deleted")
+	}
+	if strings.Contains(out.String(), "new-access-token") {
+		t.Errorf("expected token to be redacted in output, got:\n%s", out.String())
+	}
+
+	token, err := testApp.Credentials.Get(config.DefaultCredential)
+	if err != nil {
+		t.Fatalf("reading saved token: %v", err)
+	}
+	if token != "new-access-token" {
+		t.Errorf("expected access token in the credential store, got %q", token)
+	}
+
+	configData, err := os.ReadFile(tmpDir + "/.config/fizzy-cli/config.json")
+	if err != nil {
+		t.Fatalf("reading saved config: %v", err)
+	}
+	if strings.Contains(string(configData), "new-access-token") {
+		t.Errorf("expected token to be kept out of config.json, got:\n%s", configData)
+	}
+
+	info, err := os.Stat(tmpDir + "/.config/fizzy-cli/credentials.json")
+	if err != nil {
+		t.Fatalf("credentials file not created: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("expected credentials file mode 0600, got %o", perm)
+	}
+}
+
+func TestLoginCommandWithEmailInvalidCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/session":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]string{"pending_authentication_token": "pending-123"})
+		case "/session/magic_link":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("Invalid code"))
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+
+	testApp := &app.App{Config: &config.Config{}, BaseURL: server.URL, HTTPClient: server.Client()}
+
+	cmd := newEmailLoginCmd("test@example.com")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetIn(strings.NewReader("WRONG1\n"))
+	cmd.SetOut(&bytes.Buffer{})
+
+	err := handleLogin(cmd)
+	if err == nil {
+		t.Fatal("expected error for invalid code")
+	}
+	if err.Error() != "submitting sign-in code: unexpected status code 401: Invalid code" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if code := exitCode(err); code != exitAuth {
+		t.Errorf("expected exit code %d, got %d", exitAuth, code)
+	}
+}
+
+func newTokenLoginCmd(encrypt bool) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("with-token", true, "")
+	cmd.Flags().Bool("encrypt", encrypt, "")
+	return cmd
+}
+
+func identityServer(t *testing.T, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my/identity" {
+			t.Errorf("expected /my/identity, got %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer "+token {
+			t.Errorf("expected Bearer %s, got %s", token, auth)
+		}
+		json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{
+			Accounts: []fizzy.Account{{
+				Name: "Test Account",
+				Slug: "/123456",
+				User: fizzy.User{ID: "user-123", Name: "Test User", Email: "test@example.com"},
+			}},
+		})
+	}))
+}
+
+func TestLoginCommandWithTokenEncrypted(t *testing.T) {
+	server := identityServer(t, "stdin-token")
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+	t.Setenv("FIZZY_PASSPHRASE", "correct horse")
+
+	cfg := &config.Config{}
+	testApp := &app.App{
+		Config:      cfg,
+		BaseURL:     server.URL,
+		Credentials: config.NewCredentialStore(cfg, "app.fizzy.do"),
+	}
+
+	cmd := newTokenLoginCmd(true)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetIn(strings.NewReader("stdin-token\n"))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleLogin(cmd); err != nil {
+		t.Fatalf("handleLogin failed: %v", err)
+	}
+
+	if cfg.SelectedAccount != "/123456" {
+		t.Errorf("expected selected account /123456, got %q", cfg.SelectedAccount)
+	}
+
+	data, err := os.ReadFile(tmpDir + "/.config/fizzy-cli/credentials.json")
+	if err != nil {
+		t.Fatalf("reading credentials file: %v", err)
+	}
+	if strings.Contains(string(data), "stdin-token") {
+		t.Errorf("expected token to be encrypted, got:\n%s", data)
+	}
+
+	token, err := config.NewCredentialStore(cfg, "app.fizzy.do").Get(config.DefaultCredential)
+	if err != nil {
+		t.Fatalf("reading saved token: %v", err)
+	}
+	if token != "stdin-token" {
+		t.Errorf("expected stdin-token, got %q", token)
+	}
+
+	t.Setenv("FIZZY_PASSPHRASE", "wrong")
+	if _, err := config.NewCredentialStore(cfg, "app.fizzy.do").Get(config.DefaultCredential); err == nil {
+		t.Error("expected an error decrypting with the wrong passphrase")
+	}
+}
+
+func TestLoginCommandWithTokenCredentialHelper(t *testing.T) {
+	server := identityServer(t, "helper-token")
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+	t.Setenv("FIZZY_PASSPHRASE", "")
+
+	// A helper that keeps the git credential protocol input it's given.
+	store := tmpDir + "/helper-store"
+	cfg := &config.Config{
+		CredentialHelper: "!f() { if [ \"$1\" = get ]; then grep ^password= " + store + "; else cat > " + store + "; fi; }; f",
+	}
+	testApp := &app.App{
+		Config:      cfg,
+		BaseURL:     server.URL,
+		Credentials: config.NewCredentialStore(cfg, "app.fizzy.do"),
+	}
+
+	cmd := newTokenLoginCmd(false)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetIn(strings.NewReader("helper-token\n"))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleLogin(cmd); err != nil {
+		t.Fatalf("handleLogin failed: %v", err)
+	}
+
+	data, err := os.ReadFile(store)
+	if err != nil {
+		t.Fatalf("helper didn't store the token: %v", err)
+	}
+	want := "protocol=https\nhost=app.fizzy.do\nusername=default\npassword=helper-token\n\n"
+	if string(data) != want {
+		t.Errorf("expected helper input %q, got %q", want, data)
+	}
+
+	token, err := testApp.Credentials.Get(config.DefaultCredential)
+	if err != nil {
+		t.Fatalf("reading token from helper: %v", err)
+	}
+	if token != "helper-token" {
+		t.Errorf("expected helper-token, got %q", token)
+	}
+	if _, err := os.Stat(tmpDir + "/.config/fizzy-cli/credentials.json"); !os.IsNotExist(err) {
+		t.Errorf("expected no credentials file with a helper, got %v", err)
+	}
+}
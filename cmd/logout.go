@@ -1,8 +1,9 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -10,29 +11,139 @@ import (
 
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
-	Short: "Log out and destroy the session",
-	Long:  `Destroy the server-side session and log out the current user`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleLogout(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	Short: "Log out and remove local credentials",
+	Long: `Destroy the server-side session and remove what fizzy keeps locally: the
+stored access token, the API responses cached for it and the selected
+account, board and user. Each removed item is listed. The local cleanup
+happens even when the session can't be ended, e.g. for a token that no
+longer works.
+
+With --revoke, the access token is also revoked so it stops working
+everywhere. When you have more than one token, pass the ID of the one in use
+with --token-id (see 'fizzy token list'). A token that can't be revoked, e.g.
+a read-only one, is still removed locally and the error reported after.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleLogout(cmd)
 	},
 }
 
 func handleLogout(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
-	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+
+	revoke, _ := cmd.Flags().GetBool("revoke")
+	keepConfig, _ := cmd.Flags().GetBool("keep-config")
+
+	var tokenID string
+	if revoke {
+		if a.Client == nil {
+			return errNoClient
+		}
+		var err error
+		if tokenID, err = currentTokenID(cmd, a); err != nil {
+			return err
+		}
+	}
+
+	// Ending the session is best-effort: a token that no longer works, or
+	// isn't set up for an account, should still be removed below.
+	if a.Client != nil {
+		if err := a.Client.DeleteSession(cmd.Context()); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Couldn't end the session on the server: %v\n", err)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully logged out\n")
+		}
+	}
+
+	// A failed revoke is reported once the local cleanup is done, so the
+	// token is still removed from this machine.
+	var revokeErr error
+	if revoke {
+		if revokeErr = app.DeleteAccessToken(cmd.Context(), a.Client, tokenID); revokeErr == nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "✓ Revoked access token '%s'\n", tokenID)
+		}
+	}
+
+	if a.TokenFromEnv {
+		fmt.Fprintf(cmd.OutOrStdout(), "FIZZY_ACCESS_TOKEN is still set; unset it to stop using the token\n")
+	} else if a.Credentials != nil && (a.Token != "" || a.AuthErr != nil) {
+		// With AuthErr set, a token is stored but couldn't be read.
+		if err := a.Credentials.Erase(a.Config.Credential()); err != nil {
+			return fmt.Errorf("removing access token: %w", err)
+		}
+		if a.Token != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "✓ Removed access token %s from %s\n", app.RedactToken(a.Token), a.Credentials.Location())
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "✓ Removed access token from %s\n", a.Credentials.Location())
+		}
+	}
+
+	// Only this account and token's responses: other profiles keep theirs.
+	if a.Token != "" {
+		if dir, err := app.AccountCacheDir(a.SelectedAccount(), a.Token); err == nil {
+			if _, err := os.Stat(dir); err == nil {
+				if err := os.RemoveAll(dir); err != nil {
+					return fmt.Errorf("removing cached API responses: %w", err)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "✓ Removed cached API responses from %s\n", dir)
+			}
+		}
 	}
 
-	if err := a.Client.DeleteSession(context.Background()); err != nil {
-		return fmt.Errorf("logging out: %w", err)
+	// A token stored under the same name later may have another permission.
+	a.Config.SetTokenPermission("")
+	var cleared []string
+	if !keepConfig {
+		for _, setting := range []struct {
+			name  string
+			value *string
+		}{
+			{"account", &a.Config.SelectedAccount},
+			{"board", &a.Config.SelectedBoard},
+			{"user", &a.Config.CurrentUserID},
+		} {
+			if *setting.value != "" {
+				cleared = append(cleared, fmt.Sprintf("%s %s", setting.name, *setting.value))
+				*setting.value = ""
+			}
+		}
+	}
+	if err := a.Config.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	if len(cleared) > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Cleared selected %s from the config\n", strings.Join(cleared, ", "))
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "Successfully logged out\n")
+	if revokeErr != nil {
+		return fmt.Errorf("revoking access token '%s', it still works: %w", tokenID, revokeErr)
+	}
 	return nil
 }
 
+// currentTokenID returns the ID of the access token in use: the one given
+// with --token-id, or the only token there is.
+func currentTokenID(cmd *cobra.Command, a *app.App) (string, error) {
+	if id, _ := cmd.Flags().GetString("token-id"); id != "" {
+		return id, nil
+	}
+
+	tokens, err := app.ListAccessTokens(cmd.Context(), a.Client)
+	if err != nil {
+		return "", fmt.Errorf("fetching access tokens: %w", err)
+	}
+	if len(tokens) != 1 {
+		return "", &usageError{fmt.Errorf("--revoke: can't tell which of your %d access tokens is in use, pass its ID with --token-id (see 'fizzy token list')", len(tokens))}
+	}
+	return tokens[0].ID, nil
+}
+
 func init() {
+	logoutCmd.Flags().Bool("revoke", false, "Also revoke the access token")
+	logoutCmd.Flags().String("token-id", "", "ID of the access token in use, for --revoke")
+	logoutCmd.Flags().Bool("keep-config", false, "Keep the selected account, board and user in the config")
 	rootCmd.AddCommand(logoutCmd)
 }
@@ -1,13 +1,21 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestLogoutCommand(t *testing.T) {
@@ -31,8 +39,10 @@ func TestLogoutCommand(t *testing.T) {
 	}))
 	defer server.Close()
 
+	t.Setenv("HOME", t.TempDir())
+
 	client := testutil.NewTestClient(server.URL, "", "", "test-token")
-	testApp := &app.App{Client: client}
+	testApp := &app.App{Client: client, Config: &config.Config{}}
 
 	cmd := logoutCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
@@ -42,55 +52,316 @@ func TestLogoutCommand(t *testing.T) {
 	}
 }
 
-func TestLogoutCommandAPIError(t *testing.T) {
+func TestLogoutCommandSessionErrors(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusUnauthorized} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+				w.Write([]byte(http.StatusText(status)))
+			}))
+			defer server.Close()
+
+			testApp := loggedInApp(t, server)
+
+			cmd := newLogoutCmd()
+			cmd.SetContext(testApp.ToContext(context.Background()))
+			cmd.SetOut(&bytes.Buffer{})
+			var errOut bytes.Buffer
+			cmd.SetErr(&errOut)
+
+			if err := handleLogout(cmd); err != nil {
+				t.Fatalf("handleLogout failed: %v", err)
+			}
+			if !strings.Contains(errOut.String(), "Couldn't end the session on the server") {
+				t.Errorf("expected a note about the session, got:\n%s", errOut.String())
+			}
+			if token, _ := testApp.Credentials.Get(config.DefaultCredential); token != "" {
+				t.Errorf("expected stored token to be removed anyway, got %q", token)
+			}
+		})
+	}
+}
+
+func TestLogoutCommandNoClient(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(a *app.App)
+		want  string
+	}{
+		{"token without account", func(a *app.App) {}, "✓ Removed access token test-t..."},
+		{"unreadable token", func(a *app.App) {
+			a.Token = ""
+			a.AuthErr = errors.New("loading access token: wrong passphrase")
+		}, "✓ Removed access token from "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("expected no requests without a client, got %s %s", r.Method, r.URL.Path)
+			}))
+			defer server.Close()
+
+			testApp := loggedInApp(t, server)
+			testApp.Client = nil
+			tt.setup(testApp)
+
+			cmd := newLogoutCmd()
+			cmd.SetContext(testApp.ToContext(context.Background()))
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+
+			if err := handleLogout(cmd); err != nil {
+				t.Fatalf("handleLogout failed: %v", err)
+			}
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.want, out.String())
+			}
+			if token, _ := testApp.Credentials.Get(config.DefaultCredential); token != "" {
+				t.Errorf("expected stored token to be removed, got %q", token)
+			}
+			saved, err := config.Load()
+			if err != nil {
+				t.Fatalf("loading config: %v", err)
+			}
+			if saved.SelectedAccount != "" || saved.SelectedBoard != "" {
+				t.Errorf("expected selection to be cleared, got %+v", saved)
+			}
+		})
+	}
+}
+
+func TestLogoutCommandRevokeNoClient(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	testApp := &app.App{Config: &config.Config{}}
+
+	cmd := newLogoutCmd("--revoke")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleLogout(cmd)
+	if err == nil || err.Error() != "API client not available" {
+		t.Errorf("expected 'client not available' error, got %v", err)
+	}
+}
+
+func newLogoutCmd(args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("revoke", false, "")
+	cmd.Flags().String("token-id", "", "")
+	cmd.Flags().Bool("keep-config", false, "")
+	cmd.ParseFlags(args)
+	return cmd
+}
+
+// loggedInApp returns an app with a stored token, a selection in the config
+// and a cached response, for server, next to one for another account.
+func loggedInApp(t *testing.T, server *httptest.Server) *app.App {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+	t.Setenv("FIZZY_PASSPHRASE", "")
+
+	cfg := &config.Config{SelectedAccount: "/test-account", SelectedBoard: "board-1", CurrentUserID: "user-1"}
+	cfg.SetTokenPermission("write")
+	store := config.NewCredentialStore(cfg, "app.fizzy.do")
+	if err := store.Store(config.DefaultCredential, "test-token"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, account := range []string{"/test-account", "/other-account"} {
+		dir, err := app.AccountCacheDir(account, "test-token")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "entry.json"), []byte("{}"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Config:      cfg,
+		Token:       "test-token",
+		Credentials: store,
+	}
+}
+
+func TestLogoutCommandCleansLocalState(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte("Internal Server Error"))
+		if r.Method != http.MethodDelete || r.URL.Path != "/session" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer server.Close()
 
-	client := testutil.NewTestClient(server.URL, "", "", "test-token")
-	testApp := &app.App{Client: client}
+	testApp := loggedInApp(t, server)
 
-	cmd := logoutCmd
+	cmd := newLogoutCmd()
 	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
 
-	err := handleLogout(cmd)
-	if err == nil {
-		t.Errorf("expected error for API failure")
+	if err := handleLogout(cmd); err != nil {
+		t.Fatalf("handleLogout failed: %v", err)
+	}
+
+	if token, _ := testApp.Credentials.Get(config.DefaultCredential); token != "" {
+		t.Errorf("expected stored token to be removed, got %q", token)
+	}
+	cacheDir, _ := app.AccountCacheDir("/test-account", "test-token")
+	if _, err := os.Stat(cacheDir); !os.IsNotExist(err) {
+		t.Errorf("expected the account's cache to be removed, got %v", err)
+	}
+	otherDir, _ := app.AccountCacheDir("/other-account", "test-token")
+	if _, err := os.Stat(otherDir); err != nil {
+		t.Errorf("expected other accounts' cache to be kept, got %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if saved.SelectedAccount != "" || saved.SelectedBoard != "" || saved.CurrentUserID != "" {
+		t.Errorf("expected selection to be cleared, got %+v", saved)
+	}
+	if saved.TokenPermission() != "" {
+		t.Errorf("expected token permission to be forgotten, got %q", saved.TokenPermission())
+	}
+
+	for _, want := range []string{
+		"✓ Removed access token test-t...",
+		"✓ Removed cached API responses from " + cacheDir,
+		"✓ Cleared selected account /test-account, board board-1, user user-1 from the config",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
 	}
 }
 
-func TestLogoutCommandNoClient(t *testing.T) {
-	testApp := &app.App{}
+func TestLogoutCommandKeepConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
 
-	cmd := logoutCmd
+	testApp := loggedInApp(t, server)
+
+	cmd := newLogoutCmd("--keep-config")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleLogout(cmd); err != nil {
+		t.Fatalf("handleLogout failed: %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if saved.SelectedAccount != "/test-account" || saved.SelectedBoard != "board-1" {
+		t.Errorf("expected selection to be kept, got %+v", saved)
+	}
+	if strings.Contains(out.String(), "Cleared selected") {
+		t.Errorf("expected no config to be reported cleared, got:\n%s", out.String())
+	}
+}
+
+func TestLogoutCommandRevoke(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/my/access_tokens":
+			json.NewEncoder(w).Encode([]app.AccessToken{{ID: "tok-1", Permission: "write"}})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	testApp := loggedInApp(t, server)
+
+	cmd := newLogoutCmd("--revoke")
 	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleLogout(cmd); err != nil {
+		t.Fatalf("handleLogout failed: %v", err)
+	}
+
+	want := []string{
+		"GET /my/access_tokens",
+		"DELETE /session",
+		"DELETE /my/access_tokens/tok-1",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected requests:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(requests, "\n"))
+	}
+}
+
+func TestLogoutCommandRevokeFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/my/access_tokens":
+			json.NewEncoder(w).Encode([]app.AccessToken{{ID: "tok-1", Permission: "read"}})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	// A read-only token's revoke is refused before it leaves the client.
+	testApp := loggedInApp(t, server)
+	testApp.Client.HTTPClient = &http.Client{Transport: &app.ReadOnlyTransport{}}
+
+	cmd := newLogoutCmd("--revoke")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
 
 	err := handleLogout(cmd)
-	if err == nil {
-		t.Errorf("expected error when client not available")
+	if code := exitCode(err); code != exitPermission {
+		t.Errorf("expected the revoke's permission error, got %v", err)
 	}
-	if err.Error() != "API client not available" {
-		t.Errorf("expected 'client not available' error, got %v", err)
+	if token, _ := testApp.Credentials.Get(config.DefaultCredential); token != "" {
+		t.Errorf("expected stored token to be removed anyway, got %q", token)
+	}
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if saved.SelectedAccount != "" {
+		t.Errorf("expected selection to be cleared anyway, got %+v", saved)
+	}
+	if strings.Contains(out.String(), "Revoked") {
+		t.Errorf("expected the token not to be reported revoked, got:\n%s", out.String())
 	}
 }
 
-func TestLogoutCommandUnauthorized(t *testing.T) {
+func TestLogoutCommandRevokeAmbiguous(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write([]byte("Unauthorized"))
+		if r.Method != http.MethodGet {
+			t.Errorf("expected nothing but the token list, got %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]app.AccessToken{{ID: "tok-1"}, {ID: "tok-2"}})
 	}))
 	defer server.Close()
 
-	client := testutil.NewTestClient(server.URL, "", "", "invalid-token")
-	testApp := &app.App{Client: client}
+	testApp := loggedInApp(t, server)
 
-	cmd := logoutCmd
+	cmd := newLogoutCmd("--revoke")
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
 	err := handleLogout(cmd)
-	if err == nil {
-		t.Errorf("expected error for unauthorized request")
+	if code := exitCode(err); code != exitUsage {
+		t.Errorf("expected usage error, got %v", err)
+	}
+	if token, _ := testApp.Credentials.Get(config.DefaultCredential); token != "test-token" {
+		t.Errorf("expected nothing to be removed, got token %q", token)
 	}
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -14,25 +13,28 @@ var notificationListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all notifications",
 	Long:  `Retrieve and display all notifications from Fizzy`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListNotifications(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListNotifications(cmd)
 	},
 }
 
 func handleListNotifications(cmd *cobra.Command) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	opts := &fizzy.ListOptions{}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		opts.Limit = limit
 	}
 
-	notifications, err := a.Client.GetNotifications(context.Background(), opts)
+	notifications, err := a.Client.GetNotifications(cmd.Context(), opts)
 	if err != nil {
 		return fmt.Errorf("fetching notifications: %w", err)
 	}
@@ -42,12 +44,13 @@ func handleListNotifications(cmd *cobra.Command) error {
 
 	filtered := filterNotifications(notifications, read, unread)
 
-	if len(filtered) == 0 {
-		fmt.Println("No notifications found")
-		return nil
-	}
-
-	return ui.DisplayNotifications(filtered)
+	return printList(cmd, filtered, func() error {
+		if len(filtered) == 0 {
+			fmt.Println("No notifications found")
+			return nil
+		}
+		return ui.DisplayNotifications(filtered)
+	})
 }
 
 func filterNotifications(notifications []fizzy.Notification, read bool, unread bool) []fizzy.Notification {
@@ -72,4 +75,5 @@ func init() {
 	notificationListCmd.Flags().BoolP("read", "r", false, "Show only read notifications")
 	notificationListCmd.Flags().BoolP("unread", "u", false, "Show only unread notifications")
 	notificationListCmd.Flags().IntP("limit", "l", 0, "Maximum number of notifications to return (0 = no limit)")
+	addListOutputFlags(notificationListCmd)
 }
@@ -1,9 +1,8 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
@@ -15,35 +14,35 @@ var notificationReadCmd = &cobra.Command{
 	Short: "Mark notification as read and display it",
 	Long:  `Mark a notification as read and display its content`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleReadNotification(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleReadNotification(cmd, args[0])
 	},
 }
 
 func handleReadNotification(cmd *cobra.Command, notificationID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.MarkNotificationRead(context.Background(), notificationID); err != nil {
-		if strings.Contains(err.Error(), "404") {
-			return fmt.Errorf("notification not found")
+	if err := a.Client.MarkNotificationRead(cmd.Context(), notificationID); err != nil {
+		if app.StatusCode(err) == http.StatusNotFound {
+			return &statusError{http.StatusNotFound, "notification not found"}
 		}
 		return fmt.Errorf("marking notification as read: %w", err)
 	}
 
-	notification, err := a.Client.GetNotification(context.Background(), notificationID)
+	notification, err := a.Client.GetNotification(cmd.Context(), notificationID)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			return fmt.Errorf("notification not found")
+		if app.StatusCode(err) == http.StatusNotFound {
+			return &statusError{http.StatusNotFound, "notification not found"}
 		}
 		return fmt.Errorf("fetching notification: %w", err)
 	}
 
-	return ui.DisplayNotification(notification)
+	return printResult(cmd, notification, func() error {
+		return ui.DisplayNotification(notification)
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -12,20 +11,18 @@ var notificationReadAllCmd = &cobra.Command{
 	Use:   "read-all",
 	Short: "Mark all unread notifications as read",
 	Long:  `Mark all unread notifications as read`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleReadAllNotifications(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleReadAllNotifications(cmd)
 	},
 }
 
 func handleReadAllNotifications(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.MarkAllNotificationsRead(context.Background()); err != nil {
+	if err := a.Client.MarkAllNotificationsRead(cmd.Context()); err != nil {
 		return fmt.Errorf("marking all notifications as read: %w", err)
 	}
 
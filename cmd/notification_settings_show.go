@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,25 +12,25 @@ var notificationSettingsShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Show notification settings",
 	Long:  `Retrieve and display the current user's notification settings`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowNotificationSettings(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowNotificationSettings(cmd)
 	},
 }
 
 func handleShowNotificationSettings(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	settings, err := a.Client.GetNotificationSettings(context.Background())
+	settings, err := a.Client.GetNotificationSettings(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching notification settings: %w", err)
 	}
 
-	return ui.DisplayNotificationSettings(cmd.OutOrStdout(), settings)
+	return printResult(cmd, settings, func() error {
+		return ui.DisplayNotificationSettings(cmd.OutOrStdout(), settings)
+	})
 }
 
 func init() {
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -13,10 +12,8 @@ var notificationSettingsUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update notification settings",
 	Long:  `Update the current user's notification settings`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateNotificationSettings(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateNotificationSettings(cmd)
 	},
 }
 
@@ -27,18 +24,26 @@ func handleUpdateNotificationSettings(cmd *cobra.Command) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	freq, _ := cmd.Flags().GetString("bundle-email-frequency")
 	payload := fizzy.UpdateNotificationSettingsPayload{BundleEmailFrequency: freq}
 
-	if err := a.Client.UpdateNotificationSettings(context.Background(), payload); err != nil {
+	if err := a.Client.UpdateNotificationSettings(cmd.Context(), payload); err != nil {
 		return fmt.Errorf("updating notification settings: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Notification settings updated\n")
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		settings, err := a.Client.GetNotificationSettings(cmd.Context())
+		if err != nil {
+			return nil, fmt.Errorf("fetching notification settings: %w", err)
+		}
+		return settings, nil
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Notification settings updated\n")
+		return nil
+	})
 }
 
 func init() {
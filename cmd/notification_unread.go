@@ -1,9 +1,8 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,23 +13,21 @@ var notificationUnreadCmd = &cobra.Command{
 	Short: "Mark notification as unread",
 	Long:  `Mark a notification as unread`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUnreadNotification(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUnreadNotification(cmd, args[0])
 	},
 }
 
 func handleUnreadNotification(cmd *cobra.Command, notificationID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err := a.Client.MarkNotificationUnread(context.Background(), notificationID)
+	err := a.Client.MarkNotificationUnread(cmd.Context(), notificationID)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			return fmt.Errorf("notification not found")
+		if app.StatusCode(err) == http.StatusNotFound {
+			return &statusError{http.StatusNotFound, "notification not found"}
 		}
 		return fmt.Errorf("marking notification as unread: %w", err)
 	}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// outputFormat returns the format selected with the global --output flag.
+func outputFormat(cmd *cobra.Command) (ui.Format, error) {
+	flag := cmd.Flag("output")
+	if flag == nil {
+		return ui.FormatText, nil
+	}
+	return ui.ParseFormat(flag.Value.String())
+}
+
+// checkOutputFormat reports a --output value cmd can't print, before any
+// request is made: tabular formats only fit list commands, which are the
+// ones with the --template flag.
+func checkOutputFormat(cmd *cobra.Command) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if format.IsTabular() && cmd.Flags().Lookup("template") == nil {
+		return fmt.Errorf("--output %s is only supported by list commands", format)
+	}
+	return nil
+}
+
+// printResult writes v in the format selected with --output. For the default
+// text format display is called instead, so each command keeps its own
+// human-readable rendering.
+func printResult(cmd *cobra.Command, v any, display func() error) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if format == ui.FormatText {
+		return display()
+	}
+	if format.IsTabular() {
+		return fmt.Errorf("--output %s is only supported by list commands", format)
+	}
+	return ui.Encode(cmd.OutOrStdout(), format, v)
+}
+
+// printFetchedResult is printResult for endpoints that respond without a
+// body: fetch is only called when a structured format was requested, to load
+// the resource the command just changed.
+func printFetchedResult(cmd *cobra.Command, fetch func() (any, error), display func() error) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if format == ui.FormatText {
+		return display()
+	}
+	if format.IsTabular() {
+		return fmt.Errorf("--output %s is only supported by list commands", format)
+	}
+	v, err := fetch()
+	if err != nil {
+		return err
+	}
+	return ui.Encode(cmd.OutOrStdout(), format, v)
+}
+
+// addListOutputFlags registers the --template and --fields flags that list
+// commands accept on top of the global --output flag.
+func addListOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().String("template", "", "Go template applied to each item, e.g. '{{.Number}}\\t{{.Title}}'")
+	cmd.Flags().StringSlice("fields", nil, "Comma-separated fields to print, e.g. number,title,column.name")
+	cmd.MarkFlagsMutuallyExclusive("template", "fields")
+}
+
+// printList is printResult for list commands, adding the --template and
+// --fields renderings.
+func printList(cmd *cobra.Command, items any, display func() error) error {
+	return printListOf(cmd, items, items, display)
+}
+
+// printListOf is printList for responses that wrap their list: v is what
+// --output encodes, items is the slice --template and --fields walk over.
+func printListOf(cmd *cobra.Command, v any, items any, display func() error) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	tmpl, _ := cmd.Flags().GetString("template")
+	fields, _ := cmd.Flags().GetStringSlice("fields")
+
+	switch {
+	case tmpl != "":
+		if format != ui.FormatText {
+			return fmt.Errorf("--template cannot be combined with --output %s", format)
+		}
+		return ui.DisplayTemplate(cmd.OutOrStdout(), tmpl, items)
+	case len(fields) > 0:
+		table, err := ui.SelectFields(items, fields)
+		if err != nil {
+			return err
+		}
+		switch {
+		case format == ui.FormatText:
+			return ui.DisplayTable(cmd.OutOrStdout(), table)
+		case format.IsTabular():
+			return ui.WriteTable(cmd.OutOrStdout(), format, table)
+		}
+		return ui.Encode(cmd.OutOrStdout(), format, table.Records)
+	case format.IsTabular():
+		table, err := ui.SelectFields(items, ui.DefaultFields(items))
+		if err != nil {
+			return err
+		}
+		return ui.WriteTable(cmd.OutOrStdout(), format, table)
+	}
+
+	return printResult(cmd, v, display)
+}
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/spf13/cobra"
+)
+
+func newOutputCmd(format string) (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("output", "o", "text", "Output format")
+	cmd.Flags().Set("output", format)
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	return cmd, &buf
+}
+
+func TestPrintResultText(t *testing.T) {
+	cmd, buf := newOutputCmd("text")
+
+	called := false
+	err := printResult(cmd, fizzy.Card{Number: 1}, func() error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("printResult failed: %v", err)
+	}
+	if !called {
+		t.Errorf("expected display to be called for text output")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no encoded output, got %q", buf.String())
+	}
+}
+
+func TestPrintResultJSON(t *testing.T) {
+	cmd, buf := newOutputCmd("json")
+
+	card := fizzy.Card{ID: "card-123", Number: 12, Title: "Fix bug", Tags: []string{"bug"}}
+	err := printResult(cmd, card, func() error {
+		t.Errorf("display should not be called for json output")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("printResult failed: %v", err)
+	}
+
+	var decoded fizzy.Card
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if decoded.Number != 12 || decoded.Title != "Fix bug" {
+		t.Errorf("expected card #12 'Fix bug', got #%d '%s'", decoded.Number, decoded.Title)
+	}
+}
+
+func TestPrintResultJSONNilSlice(t *testing.T) {
+	cmd, buf := newOutputCmd("json")
+
+	var cards []fizzy.Card
+	if err := printResult(cmd, cards, func() error { return nil }); err != nil {
+		t.Fatalf("printResult failed: %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("expected [], got %q", buf.String())
+	}
+}
+
+func TestPrintResultYAML(t *testing.T) {
+	cmd, buf := newOutputCmd("yaml")
+
+	card := fizzy.Card{Number: 12, Title: "true", LastActiveAt: "2025-01-01T00:00:00Z"}
+	if err := printResult(cmd, card, func() error { return nil }); err != nil {
+		t.Fatalf("printResult failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"number: 12\n", "title: \"true\"\n", "last_active_at: \"2025-01-01T00:00:00Z\"\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected YAML output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "id:") > strings.Index(out, "number:") {
+		t.Errorf("expected YAML keys to keep the JSON field order, got:\n%s", out)
+	}
+}
+
+func TestPrintResultInvalidFormat(t *testing.T) {
+	cmd, _ := newOutputCmd("xml")
+
+	err := printResult(cmd, fizzy.Card{}, func() error { return nil })
+	if err == nil {
+		t.Fatalf("expected error for invalid output format")
+	}
+	if err.Error() != "invalid output format 'xml'. Available formats: text, json, yaml, csv, tsv, markdown" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func newListOutputCmd(args ...string) (*cobra.Command, *bytes.Buffer) {
+	cmd, buf := newOutputCmd("text")
+	addListOutputFlags(cmd)
+	cmd.ParseFlags(args)
+	return cmd, buf
+}
+
+var outputTestCards = []fizzy.Card{
+	{Number: 1, Title: "Implement feature", Tags: []string{"feature", "ui"}, Column: &fizzy.Column{Name: "Doing"}},
+	{Number: 2, Title: "Fix bug"},
+}
+
+func TestPrintListTemplate(t *testing.T) {
+	cmd, buf := newListOutputCmd("--template", `{{.Number}}\t{{.Title}}{{with .Column}} [{{.Name}}]{{end}}`)
+
+	if err := printList(cmd, outputTestCards, func() error { return nil }); err != nil {
+		t.Fatalf("printList failed: %v", err)
+	}
+
+	expected := "1\tImplement feature [Doing]\n2\tFix bug\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestPrintListTemplateWithStructuredOutput(t *testing.T) {
+	cmd, _ := newListOutputCmd("--template", "{{.Number}}", "--output", "json")
+
+	err := printList(cmd, outputTestCards, func() error { return nil })
+	if err == nil {
+		t.Fatalf("expected error when combining --template with --output json")
+	}
+	if err.Error() != "--template cannot be combined with --output json" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPrintListFields(t *testing.T) {
+	cmd, buf := newListOutputCmd("--fields", "number,title,column.name,tags")
+
+	if err := printList(cmd, outputTestCards, func() error { return nil }); err != nil {
+		t.Fatalf("printList failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %q", buf.String())
+	}
+	if strings.Join(strings.Fields(lines[0]), " ") != "NUMBER TITLE COLUMN.NAME TAGS" {
+		t.Errorf("unexpected header: %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "Doing") || !strings.HasSuffix(lines[1], "feature, ui") {
+		t.Errorf("unexpected first row: %q", lines[1])
+	}
+	if strings.Join(strings.Fields(lines[2]), " ") != "2 Fix bug" {
+		t.Errorf("unexpected second row: %q", lines[2])
+	}
+}
+
+func TestPrintListFieldsJSON(t *testing.T) {
+	cmd, buf := newListOutputCmd("--fields", "title,number", "--output", "json")
+
+	if err := printList(cmd, outputTestCards, func() error { return nil }); err != nil {
+		t.Fatalf("printList failed: %v", err)
+	}
+
+	var records []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(records) != 2 || records[0]["title"] != "Implement feature" || records[0]["number"] != float64(1) {
+		t.Errorf("unexpected records: %v", records)
+	}
+	if len(records[0]) != 2 {
+		t.Errorf("expected only the selected fields, got %v", records[0])
+	}
+	if !strings.HasPrefix(strings.TrimSpace(buf.String()), "[\n  {\n    \"title\"") {
+		t.Errorf("expected fields in the requested order, got %s", buf.String())
+	}
+}
+
+func TestPrintListUnknownField(t *testing.T) {
+	cmd, _ := newListOutputCmd("--fields", "number,assignees")
+
+	err := printList(cmd, outputTestCards, func() error { return nil })
+	if err == nil {
+		t.Fatalf("expected error for unknown field")
+	}
+	if !strings.HasPrefix(err.Error(), "unknown field 'assignees'. Available fields: ") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPrintListCSV(t *testing.T) {
+	cmd, buf := newListOutputCmd("--output", "csv", "--fields", "number,title,column,tags")
+
+	if err := printList(cmd, outputTestCards, func() error { return nil }); err != nil {
+		t.Fatalf("printList failed: %v", err)
+	}
+
+	expected := "number,title,column,tags\n1,Implement feature,Doing,\"feature, ui\"\n2,Fix bug,,\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestPrintListTSVDefaultFields(t *testing.T) {
+	cmd, buf := newListOutputCmd("--output", "tsv")
+
+	users := []fizzy.User{{ID: "user-1", Name: "Jane", Email: "jane@example.com", Role: "admin", Active: true}}
+	if err := printList(cmd, users, func() error { return nil }); err != nil {
+		t.Fatalf("printList failed: %v", err)
+	}
+
+	expected := "id\tname\temail_address\trole\tactive\nuser-1\tJane\tjane@example.com\tadmin\ttrue\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestPrintListMarkdown(t *testing.T) {
+	cmd, buf := newListOutputCmd("--output", "markdown", "--fields", "number,title")
+
+	cards := []fizzy.Card{{Number: 1, Title: "Ship it 🚀"}, {Number: 12, Title: "a|b"}}
+	if err := printList(cmd, cards, func() error { return nil }); err != nil {
+		t.Fatalf("printList failed: %v", err)
+	}
+
+	expected := "| number | title      |\n" +
+		"| ------ | ---------- |\n" +
+		"| 1      | Ship it 🚀 |\n" +
+		"| 12     | a\\|b       |\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestPrintResultTabularFormatNotSupported(t *testing.T) {
+	cmd, _ := newOutputCmd("csv")
+
+	err := printResult(cmd, fizzy.Card{}, func() error { return nil })
+	if err == nil {
+		t.Fatalf("expected error for csv output on a single resource")
+	}
+	if err.Error() != "--output csv is only supported by list commands" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckOutputFormat(t *testing.T) {
+	cmd, _ := newOutputCmd("tsv")
+	if err := checkOutputFormat(cmd); err == nil || err.Error() != "--output tsv is only supported by list commands" {
+		t.Errorf("expected tsv to be refused for a non-list command, got %v", err)
+	}
+
+	listCmd, _ := newListOutputCmd("--output", "tsv")
+	if err := checkOutputFormat(listCmd); err != nil {
+		t.Errorf("expected tsv to be accepted for a list command, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// mutatingAnnotation marks commands that change data through the API, which
+// a read-only token isn't allowed to. They fail before making any request;
+// app.ReadOnlyTransport refuses the changes of any command missing here.
+const mutatingAnnotation = "fizzy:mutating"
+
+var mutatingCommands = []*cobra.Command{
+	accountEntropyCmd, accountJoincodeResetCmd, accountJoincodeUpdateCmd,
+	boardCreateCmd, boardDeleteCmd, boardEntropyCmd, boardPublishCmd, boardUnpublishCmd, boardUpdateCmd,
+	cardAssignCmd, cardCloseCmd, cardCreateCmd, cardDeleteCmd, cardGoldenCmd, cardImageDeleteCmd,
+	cardNotNowCmd, cardPinCmd, cardReactionCreateCmd, cardReactionDeleteCmd, cardReopenCmd, cardTagCmd,
+	cardTriageCmd, cardUngoldenCmd, cardUnpinCmd, cardUntriagedCmd, cardUnwatchCmd, cardUpdateCmd, cardWatchCmd,
+	columnCreateCmd, columnDeleteCmd, columnUpdateCmd,
+	commentCreateCmd, commentDeleteCmd, commentUpdateCmd,
+	exportAccountCreateCmd, exportUserCreateCmd,
+	notificationReadCmd, notificationReadAllCmd, notificationSettingsUpdateCmd, notificationUnreadCmd,
+	reactionCreateCmd, reactionDeleteCmd,
+	stepCreateCmd, stepDeleteCmd, stepUpdateCmd,
+	timezoneSetCmd,
+	tokenCreateCmd, tokenRevokeCmd, tokenRotateCmd,
+	userAvatarDeleteCmd, userDeactivateCmd, userEmailConfirmChangeCmd, userEmailRequestChangeCmd, userUpdateCmd,
+	webhookActivateCmd, webhookCreateCmd, webhookDeleteCmd, webhookUpdateCmd,
+}
+
+// checkPermission fails a mutating command before it makes any request
+// when the token in use is known to be read-only.
+func checkPermission(cmd *cobra.Command, a *app.App) error {
+	if cmd.Annotations[mutatingAnnotation] == "" || a.Permission != "read" {
+		return nil
+	}
+	return &statusError{http.StatusForbidden, fmt.Sprintf("'%s' makes changes, but the access token in use is read-only; log in with a write token to use it", cmd.CommandPath())}
+}
+
+func init() {
+	for _, cmd := range mutatingCommands {
+		if cmd.Annotations == nil {
+			cmd.Annotations = map[string]string{}
+		}
+		cmd.Annotations[mutatingAnnotation] = "true"
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+)
+
+func TestCheckPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission string
+		mutating   bool
+		wantErr    bool
+	}{
+		{"read token, mutating command", "read", true, true},
+		{"read token, read command", "read", false, false},
+		{"write token, mutating command", "write", true, false},
+		{"unknown permission, mutating command", "", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := cardListCmd
+			if tt.mutating {
+				cmd = cardCloseCmd
+			}
+
+			err := checkPermission(cmd, &app.App{Permission: tt.permission})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && exitCode(err) != exitPermission {
+				t.Errorf("expected exit code %d, got %d", exitPermission, exitCode(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// pickBoard asks which board to use, for commands run on a terminal without
+// one. Elsewhere it fails with missing as a usage error, before fetching
+// anything.
+func pickBoard(cmd *cobra.Command, missing string) (*fizzy.Board, error) {
+	if !ui.IsTerminal() {
+		return nil, &usageError{errors.New(missing)}
+	}
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return nil, errNoClient
+	}
+	boards, err := a.Client.GetBoards(cmd.Context(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching boards: %w", err)
+	}
+
+	items := make([]ui.PickerItem, len(boards))
+	for i, b := range boards {
+		items[i] = ui.PickerItem{
+			Label:   b.Name,
+			Detail:  b.ID,
+			Preview: fmt.Sprintf("Created by %s, %s\n%s", b.Creator.Name, ui.FormatTime(b.CreatedAt), b.URL),
+		}
+	}
+	i, err := pick("Select a board:", items, missing)
+	if err != nil {
+		return nil, err
+	}
+	return &boards[i], nil
+}
+
+// pickColumn asks which of the selected board's columns to use, like
+// pickBoard.
+func pickColumn(cmd *cobra.Command, missing string) (*fizzy.Column, error) {
+	if !ui.IsTerminal() {
+		return nil, &usageError{errors.New(missing)}
+	}
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return nil, errNoClient
+	}
+	columns, err := a.Client.GetColumns(cmd.Context())
+	if err != nil {
+		return nil, fmt.Errorf("fetching columns: %w", err)
+	}
+
+	items := make([]ui.PickerItem, len(columns))
+	for i, c := range columns {
+		items[i] = ui.PickerItem{Label: c.Name, Detail: c.ID}
+	}
+	i, err := pick("Select a column:", items, missing)
+	if err != nil {
+		return nil, err
+	}
+	return &columns[i], nil
+}
+
+// pickUsers asks which users to use, any number of them, like pickBoard.
+func pickUsers(cmd *cobra.Command, missing string) ([]fizzy.User, error) {
+	if !ui.IsTerminal() {
+		return nil, &usageError{errors.New(missing)}
+	}
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return nil, errNoClient
+	}
+	users, err := a.Client.GetUsers(cmd.Context())
+	if err != nil {
+		return nil, fmt.Errorf("fetching users: %w", err)
+	}
+
+	items := make([]ui.PickerItem, len(users))
+	for i, u := range users {
+		items[i] = ui.PickerItem{
+			Label:   u.Name,
+			Detail:  u.Email,
+			Preview: fmt.Sprintf("%s, joined %s", u.Role, ui.FormatTime(u.CreatedAt)),
+		}
+	}
+	indexes, err := ui.PickMany("Select users:", items)
+	if err != nil {
+		return nil, pickError(err, missing)
+	}
+	picked := make([]fizzy.User, len(indexes))
+	for i, j := range indexes {
+		picked[i] = users[j]
+	}
+	return picked, nil
+}
+
+func pick(title string, items []ui.PickerItem, missing string) (int, error) {
+	i, err := ui.Pick(title, items)
+	if err != nil {
+		return -1, pickError(err, missing)
+	}
+	return i, nil
+}
+
+// pickError reports a picker that couldn't be shown as the missing argument
+// it was meant to fill in.
+func pickError(err error, missing string) error {
+	if errors.Is(err, ui.ErrNotTerminal) {
+		return &usageError{errors.New(missing)}
+	}
+	return err
+}
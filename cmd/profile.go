@@ -0,0 +1,18 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage named profiles",
+	Long: `Manage named profiles, each with its own account, board, access token and
+API base URL, for working across several accounts.
+
+The profile in use is the one given with --profile, then FIZZY_PROFILE, then
+the one chosen with 'fizzy profile use'. Without any, the account and board
+selected with 'fizzy use' and the token saved by 'fizzy login' are used.`,
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var profileAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a profile",
+	Long: `Add a named profile to the config file.
+
+The profile's token is stored under the profile's name, or the name given
+with --credential to share a token between profiles. Save it with
+--with-token, or later with 'fizzy login --profile <name>'.
+
+Example:
+  pass show fizzy/client | fizzy profile add client --account 897362094 --with-token`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleAddProfile(cmd, args[0])
+	},
+}
+
+func handleAddProfile(cmd *cobra.Command, name string) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+	if _, ok := a.Config.Profiles[name]; ok {
+		return fmt.Errorf("profile '%s' already exists", name)
+	}
+
+	account, _ := cmd.Flags().GetString("account")
+	board, _ := cmd.Flags().GetString("board")
+	baseURL, _ := cmd.Flags().GetString("base-url")
+	credential, _ := cmd.Flags().GetString("credential")
+	if baseURL != "" {
+		var err error
+		if baseURL, err = app.ParseBaseURL(baseURL); err != nil {
+			return &usageError{fmt.Errorf("--base-url: %w", err)}
+		}
+	}
+
+	profile := &config.Profile{
+		Account:    app.AccountSlug(account),
+		Board:      board,
+		BaseURL:    baseURL,
+		Credential: credential,
+	}
+
+	if withToken, _ := cmd.Flags().GetBool("with-token"); withToken {
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+		store := *a.Credentials
+		if baseURL != "" {
+			store.Host = app.HostOf(baseURL)
+		}
+		if err := store.Store(profile.CredentialName(name), token); err != nil {
+			return fmt.Errorf("saving access token: %w", err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Saved access token %s to %s\n", app.RedactToken(token), store.Location())
+	}
+
+	if a.Config.Profiles == nil {
+		a.Config.Profiles = map[string]*config.Profile{}
+	}
+	a.Config.Profiles[name] = profile
+	if err := a.Config.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Added profile %s\n", name)
+	fmt.Fprintf(cmd.OutOrStdout(), "Run 'fizzy profile use %s' to switch to it, or pass --profile %s\n", name, name)
+	return nil
+}
+
+func init() {
+	profileAddCmd.Flags().String("account", "", "Account slug")
+	profileAddCmd.Flags().String("board", "", "Board ID")
+	profileAddCmd.Flags().String("base-url", "", "API base URL, for self-hosted Fizzy")
+	profileAddCmd.Flags().String("credential", "", "Name the token is stored under (default: the profile name)")
+	profileAddCmd.Flags().Bool("with-token", false, "Read the profile's access token from stdin and save it")
+
+	profileCmd.AddCommand(profileAddCmd)
+}
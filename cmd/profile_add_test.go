@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newProfileAddCmd(args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("account", "", "")
+	cmd.Flags().String("board", "", "")
+	cmd.Flags().String("base-url", "", "")
+	cmd.Flags().String("credential", "", "")
+	cmd.Flags().Bool("with-token", false, "")
+	cmd.ParseFlags(args)
+	return cmd
+}
+
+func TestProfileAddCommand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("FIZZY_PASSPHRASE", "")
+
+	cfg := &config.Config{}
+	testApp := &app.App{Config: cfg, Credentials: config.NewCredentialStore(cfg, "app.fizzy.do")}
+
+	cmd := newProfileAddCmd("--account", "/897362094", "--board", "board-123", "--base-url", "https://fizzy.example.com", "--with-token")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetIn(strings.NewReader("client-token\n"))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleAddProfile(cmd, "client"); err != nil {
+		t.Fatalf("handleAddProfile failed: %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	p, ok := saved.Profiles["client"]
+	if !ok {
+		t.Fatal("expected profile client to be saved")
+	}
+	if p.Account != "/897362094" || p.Board != "board-123" || p.BaseURL != "https://fizzy.example.com" {
+		t.Errorf("unexpected profile: %+v", p)
+	}
+
+	token, err := testApp.Credentials.Get("client")
+	if err != nil {
+		t.Fatalf("reading token: %v", err)
+	}
+	if token != "client-token" {
+		t.Errorf("expected client-token stored under the profile name, got %q", token)
+	}
+	if strings.Contains(out.String(), "client-token") {
+		t.Errorf("expected token to be redacted in output, got:\n%s", out.String())
+	}
+}
+
+func TestProfileAddCommandAccountWithoutSlash(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{}
+	testApp := &app.App{Config: cfg}
+
+	cmd := newProfileAddCmd("--account", "897362094")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleAddProfile(cmd, "client"); err != nil {
+		t.Fatalf("handleAddProfile failed: %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if got := saved.Profiles["client"].Account; got != "/897362094" {
+		t.Errorf("expected account saved as /897362094, got %q", got)
+	}
+}
+
+func TestProfileAddCommandExists(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{Profiles: map[string]*config.Profile{"client": {}}}
+	testApp := &app.App{Config: cfg}
+
+	cmd := newProfileAddCmd()
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleAddProfile(cmd, "client")
+	if err == nil || err.Error() != "profile 'client' already exists" {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+}
+
+func TestProfileAddCommandInvalidBaseURL(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	testApp := &app.App{Config: &config.Config{}}
+
+	cmd := newProfileAddCmd("--base-url", "fizzy.example.com")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleAddProfile(cmd, "client")
+	if exitCode(err) != exitUsage {
+		t.Errorf("expected usage error, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List profiles",
+	Long:  `List the profiles in the config file, marking the one in use`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListProfiles(cmd)
+	},
+}
+
+func handleListProfiles(cmd *cobra.Command) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+
+	names := make([]string, 0, len(a.Config.Profiles))
+	for name := range a.Config.Profiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	profiles := make([]ui.ProfileSummary, 0, len(names))
+	for _, name := range names {
+		p := a.Config.Profiles[name]
+		summary := ui.ProfileSummary{
+			Name:       name,
+			Account:    p.Account,
+			Board:      p.Board,
+			BaseURL:    p.BaseURL,
+			Credential: p.CredentialName(name),
+			Current:    name == a.Config.Profile(),
+		}
+		// The profile in use holds the selection as it is now.
+		if summary.Current {
+			summary.Account = a.Config.SelectedAccount
+			summary.Board = a.Config.SelectedBoard
+		}
+		profiles = append(profiles, summary)
+	}
+
+	return printList(cmd, profiles, func() error {
+		if len(profiles) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No profiles found")
+			return nil
+		}
+		return ui.DisplayProfiles(cmd.OutOrStdout(), profiles)
+	})
+}
+
+func init() {
+	addListOutputFlags(profileListCmd)
+	profileCmd.AddCommand(profileListCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func TestProfileListCommand(t *testing.T) {
+	cfg := &config.Config{Profiles: map[string]*config.Profile{
+		"work":   {Account: "/111", Board: "board-1"},
+		"client": {Account: "/222", BaseURL: "https://fizzy.example.com", Credential: "shared"},
+	}}
+	if err := cfg.UseProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SelectedBoard = "board-2"
+	testApp := &app.App{Config: cfg}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("output", "o", "json", "")
+	addListOutputFlags(cmd)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleListProfiles(cmd); err != nil {
+		t.Fatalf("handleListProfiles failed: %v", err)
+	}
+
+	var profiles []ui.ProfileSummary
+	if err := json.Unmarshal(out.Bytes(), &profiles); err != nil {
+		t.Fatalf("decoding output: %v\n%s", err, out.String())
+	}
+	want := []ui.ProfileSummary{
+		{Name: "client", Account: "/222", BaseURL: "https://fizzy.example.com", Credential: "shared"},
+		{Name: "work", Account: "/111", Board: "board-2", Credential: "work", Current: true},
+	}
+	if len(profiles) != len(want) {
+		t.Fatalf("expected %d profiles, got %d", len(want), len(profiles))
+	}
+	for i := range want {
+		if profiles[i] != want[i] {
+			t.Errorf("profile %d: expected %+v, got %+v", i, want[i], profiles[i])
+		}
+	}
+}
+
+func TestProfileListCommandEmpty(t *testing.T) {
+	testApp := &app.App{Config: &config.Config{}}
+
+	cmd := &cobra.Command{}
+	addListOutputFlags(cmd)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleListProfiles(cmd); err != nil {
+		t.Fatalf("handleListProfiles failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "No profiles found") {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var profileRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a profile",
+	Long: `Remove the named profile from the config file, along with its stored
+token unless another profile shares it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleRemoveProfile(cmd, args[0])
+	},
+}
+
+func handleRemoveProfile(cmd *cobra.Command, name string) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+	profile, ok := a.Config.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile '%s' not found", name)
+	}
+
+	delete(a.Config.Profiles, name)
+	if a.Config.CurrentProfile == name {
+		a.Config.CurrentProfile = ""
+	}
+	if err := a.Config.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Removed profile %s\n", name)
+
+	// The default token belongs to the top-level selection.
+	credential := profile.CredentialName(name)
+	if credential == config.DefaultCredential {
+		return nil
+	}
+	for other, p := range a.Config.Profiles {
+		if p.CredentialName(other) == credential {
+			return nil
+		}
+	}
+	store := *a.Credentials
+	if profile.BaseURL != "" {
+		store.Host = app.HostOf(profile.BaseURL)
+	}
+	if err := store.Erase(credential); err != nil {
+		return fmt.Errorf("removing access token: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Removed access token '%s' from %s\n", credential, store.Location())
+	return nil
+}
+
+func init() {
+	profileCmd.AddCommand(profileRemoveCmd)
+}
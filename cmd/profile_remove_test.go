@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func TestProfileRemoveCommand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("FIZZY_PASSPHRASE", "")
+
+	cfg := &config.Config{
+		CurrentProfile: "client",
+		Profiles: map[string]*config.Profile{
+			"client": {Account: "/222"},
+			"other":  {Account: "/333", Credential: "shared"},
+			"third":  {Account: "/444", Credential: "shared"},
+		},
+	}
+	store := config.NewCredentialStore(cfg, "app.fizzy.do")
+	for _, name := range []string{"client", "shared"} {
+		if err := store.Store(name, name+"-token"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	testApp := &app.App{Config: cfg, Credentials: store}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleRemoveProfile(cmd, "client"); err != nil {
+		t.Fatalf("handleRemoveProfile failed: %v", err)
+	}
+	if err := handleRemoveProfile(cmd, "other"); err != nil {
+		t.Fatalf("handleRemoveProfile failed: %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if _, ok := saved.Profiles["client"]; ok {
+		t.Error("expected profile client to be removed")
+	}
+	if saved.CurrentProfile != "" {
+		t.Errorf("expected current profile to be cleared, got %q", saved.CurrentProfile)
+	}
+
+	if token, _ := store.Get("client"); token != "" {
+		t.Errorf("expected the profile's token to be erased, got %q", token)
+	}
+	if token, _ := store.Get("shared"); token != "shared-token" {
+		t.Errorf("expected a token still used by another profile to be kept, got %q", token)
+	}
+}
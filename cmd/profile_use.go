@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+var profileUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Switch to a profile",
+	Long: `Use the named profile for subsequent commands. --profile and FIZZY_PROFILE
+still take precedence.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUseProfile(cmd, args[0])
+	},
+}
+
+func handleUseProfile(cmd *cobra.Command, name string) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+	if _, ok := a.Config.Profiles[name]; !ok {
+		return fmt.Errorf("profile '%s' not found", name)
+	}
+
+	a.Config.CurrentProfile = name
+	if err := a.Config.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Selected profile: %s\n", name)
+	return nil
+}
+
+func init() {
+	profileCmd.AddCommand(profileUseCmd)
+}
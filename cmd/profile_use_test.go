@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func TestProfileUseCommand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{
+		SelectedAccount: "/111",
+		Profiles:        map[string]*config.Profile{"client": {Account: "/222"}},
+	}
+	testApp := &app.App{Config: cfg}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleUseProfile(cmd, "client"); err != nil {
+		t.Fatalf("handleUseProfile failed: %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if saved.CurrentProfile != "client" {
+		t.Errorf("expected current profile client, got %q", saved.CurrentProfile)
+	}
+	if saved.SelectedAccount != "/111" {
+		t.Errorf("expected top-level selection to be kept, got %q", saved.SelectedAccount)
+	}
+}
+
+func TestProfileUseCommandNotFound(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	testApp := &app.App{Config: &config.Config{}}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleUseProfile(cmd, "missing")
+	if err == nil || err.Error() != "profile 'missing' not found" {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,33 +12,34 @@ var reactionCreateCmd = &cobra.Command{
 	Short: "Create a reaction on a comment",
 	Long:  `Create an emoji reaction on a comment`,
 	Args:  cobra.ExactArgs(3),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateReaction(cmd, args[0], args[1], args[2]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateReaction(cmd, args[0], args[1], args[2])
 	},
 }
 
 func handleCreateReaction(cmd *cobra.Command, cardNumber, commentID, emoji string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	_, err = a.Client.CreateCommentReaction(context.Background(), cardNum, commentID, emoji)
+	reaction, err := a.Client.CreateCommentReaction(cmd.Context(), cardNum, commentID, emoji)
 	if err != nil {
 		return fmt.Errorf("creating reaction: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Reaction %s created successfully\n", emoji)
-	return nil
+	return printResult(cmd, reaction, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Reaction %s created successfully\n", emoji)
+		return nil
+	})
 }
 
 func init() {
+	reactionCreateCmd.ValidArgsFunction = completeArgs(completeCards)
 	reactionCmd.AddCommand(reactionCreateCmd)
 }
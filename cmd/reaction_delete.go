@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var reactionDeleteCmd = &cobra.Command{
 	Short: "Delete a reaction from a comment",
 	Long:  `Delete an emoji reaction from a comment`,
 	Args:  cobra.ExactArgs(3),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteReaction(cmd, args[0], args[1], args[2]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteReaction(cmd, args[0], args[1], args[2])
 	},
 }
 
 func handleDeleteReaction(cmd *cobra.Command, cardNumber, commentID, reactionID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.DeleteCommentReaction(context.Background(), cardNum, commentID, reactionID)
+	err = a.Client.DeleteCommentReaction(cmd.Context(), cardNum, commentID, reactionID)
 	if err != nil {
 		return fmt.Errorf("deleting reaction: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleDeleteReaction(cmd *cobra.Command, cardNumber, commentID, reactionID
 }
 
 func init() {
+	reactionDeleteCmd.ValidArgsFunction = completeArgs(completeCards)
 	reactionCmd.AddCommand(reactionDeleteCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
@@ -15,37 +13,38 @@ var reactionListCmd = &cobra.Command{
 	Short: "List reactions on a comment",
 	Long:  `Retrieve and display all reactions on a comment`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListReactions(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListReactions(cmd, args[0], args[1])
 	},
 }
 
 func handleListReactions(cmd *cobra.Command, cardNumber, commentID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	reactions, err := a.Client.GetCommentReactions(context.Background(), cardNum, commentID)
+	reactions, err := a.Client.GetCommentReactions(cmd.Context(), cardNum, commentID)
 	if err != nil {
 		return fmt.Errorf("fetching reactions: %w", err)
 	}
 
-	if len(reactions) == 0 {
-		fmt.Println("No reactions found")
-		return nil
-	}
-
-	return ui.DisplayReactions(reactions)
+	return printList(cmd, reactions, func() error {
+		if len(reactions) == 0 {
+			fmt.Println("No reactions found")
+			return nil
+		}
+		return ui.DisplayReactions(reactions)
+	})
 }
 
 func init() {
+	addListOutputFlags(reactionListCmd)
+	reactionListCmd.ValidArgsFunction = completeArgs(completeCards)
 	reactionCmd.AddCommand(reactionListCmd)
 }
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// forBoard calls fn with the board query stands for, an ID or a name; see
+// byIDOrName.
+func forBoard(cmd *cobra.Command, a *app.App, query string, match app.Match, fn func(id string) error) (string, error) {
+	return byIDOrName(query, func() (string, error) {
+		board, err := a.ResolveBoard(cmd.Context(), query, match)
+		if err != nil {
+			return "", err
+		}
+		return board.ID, nil
+	}, fn)
+}
+
+// forColumn calls fn with the selected board's column query stands for, an
+// ID or a name; see byIDOrName.
+func forColumn(cmd *cobra.Command, a *app.App, query string, match app.Match, fn func(id string) error) (string, error) {
+	return byIDOrName(query, func() (string, error) {
+		column, err := a.ResolveColumn(cmd.Context(), query, match)
+		if err != nil {
+			return "", err
+		}
+		return column.ID, nil
+	}, fn)
+}
+
+// forUser calls fn with the user query stands for, an ID, a name, an email
+// address or "me"; see byIDOrName.
+func forUser(cmd *cobra.Command, a *app.App, query string, match app.Match, fn func(id string) error) (string, error) {
+	if query == "me" {
+		id, err := currentUserID(cmd.Context(), a)
+		if err != nil {
+			return "", err
+		}
+		return id, fn(id)
+	}
+	return byIDOrName(query, func() (string, error) {
+		user, err := a.ResolveUser(cmd.Context(), query, match)
+		if err != nil {
+			return "", err
+		}
+		return user.ID, nil
+	}, fn)
+}
+
+// byIDOrName calls fn with the ID query stands for. A query that doesn't
+// look like an ID is looked up as a name with resolve first, so fn never
+// sees a name. One that does is passed to fn as is, so IDs cost no extra
+// request, and looked up as a name only when the API doesn't know that ID.
+// It returns the ID fn was last called with.
+func byIDOrName(query string, resolve func() (string, error), fn func(id string) error) (string, error) {
+	if !app.LooksLikeID(query) {
+		id, err := resolve()
+		if err != nil {
+			return query, err
+		}
+		return id, fn(id)
+	}
+
+	err := fn(query)
+	if app.StatusCode(err) != http.StatusNotFound {
+		return query, err
+	}
+
+	id, resolveErr := resolve()
+	var notFound *app.ResolveError
+	switch {
+	case errors.As(resolveErr, &notFound) && (notFound.Ambiguous || len(notFound.Suggestions) > 0):
+		return query, resolveErr
+	case resolveErr != nil || id == query:
+		// Nothing better to say than the API did.
+		return query, err
+	}
+	return id, fn(id)
+}
+
+// resolveUserID returns the ID of the user query stands for, an ID, a name,
+// an email address or "me", making sure there is one before it's used.
+func resolveUserID(cmd *cobra.Command, a *app.App, query string, match app.Match) (string, error) {
+	if query == "me" {
+		return currentUserID(cmd.Context(), a)
+	}
+	user, err := a.ResolveUser(cmd.Context(), query, match)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// resolveUserIDs returns the IDs of the users with IDs, names or email
+// addresses queries, where "me" is the signed in user.
+func resolveUserIDs(cmd *cobra.Command, a *app.App, queries []string) ([]string, error) {
+	var me []int
+	var others []string
+	for i, q := range queries {
+		if q == "me" {
+			me = append(me, i)
+		} else {
+			others = append(others, q)
+		}
+	}
+
+	ids, err := a.ResolveUserIDs(cmd.Context(), others)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range me {
+		id, err := currentUserID(cmd.Context(), a)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids[:i], append([]string{id}, ids[i:]...)...)
+	}
+	return ids, nil
+}
+
+// currentUserID returns the signed in user's ID in the selected account,
+// looking it up when the account isn't the one saved at login.
+func currentUserID(ctx context.Context, a *app.App) (string, error) {
+	if id := a.CurrentUserID(); id != "" {
+		return id, nil
+	}
+	if a.Account == "" {
+		return "", fmt.Errorf("current user ID not available, please run 'fizzy login' first")
+	}
+
+	identity, err := a.Client.GetMyIdentity(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetching identity: %w", err)
+	}
+	for _, account := range identity.Accounts {
+		if account.Slug == a.Account {
+			return account.User.ID, nil
+		}
+	}
+	return "", fmt.Errorf("account '%s' not found for this access token", a.Account)
+}
+
+// parseCardNumber returns the number of the card ref stands for: a number,
+// #42 or the card's URL. A URL for a card in another account switches the
+// app to that account and the card's board.
+func parseCardNumber(cmd *cobra.Command, ref string) (int, error) {
+	a := app.FromContext(cmd.Context())
+	var baseURL string
+	if a != nil {
+		baseURL = a.BaseURL
+	}
+
+	card, err := app.ParseCardRef(ref, baseURL)
+	if err != nil {
+		return 0, err
+	}
+	if card.Account != "" && a != nil && a.Client != nil {
+		if err := a.SwitchAccountForCard(cmd.Context(), card.Account, card.Number); err != nil {
+			return 0, err
+		}
+	}
+	return card.Number, nil
+}
@@ -1,10 +1,17 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -15,24 +22,168 @@ var rootCmd = &cobra.Command{
 	Short:   "Fizzy CLI",
 	Long:    `Fizzy CLI`,
 	Version: Version,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		a, _ := app.New(Version)
-		if a != nil {
-			cmd.SetContext(a.ToContext(cmd.Context()))
+	// Errors are printed by Execute, which also picks the exit code.
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Cobra only checks required and grouped flags after this hook, so
+		// check them here to report them as usage errors too.
+		if err := cmd.ValidateRequiredFlags(); err != nil {
+			return &usageError{err}
 		}
+		if err := cmd.ValidateFlagGroups(); err != nil {
+			return &usageError{err}
+		}
+		if err := checkOutputFormat(cmd); err != nil {
+			return &usageError{err}
+		}
+		opts, err := appOptions(cmd)
+		if err != nil {
+			return err
+		}
+		commandStarted = true
+
+		a, err := app.New(Version, opts...)
+		if err != nil {
+			return err
+		}
+		cmd.SetContext(a.ToContext(cmd.Context()))
+		// A zone that no longer loads falls back to the API's timestamps.
+		if loc, err := time.LoadLocation(a.Config.Timezone); err == nil && a.Config.Timezone != "" {
+			ui.SetTimezone(loc)
+		}
+		return checkPermission(cmd, a)
 	},
 }
 
+// appOptions turns the global flags into app options. Its errors are usage
+// errors.
+func appOptions(cmd *cobra.Command) ([]app.Option, error) {
+	opts := []app.Option{app.WithPassphrasePrompt(promptPassphrase)}
+	if cmd.Flags().Changed("max-retries") {
+		maxRetries, _ := cmd.Flags().GetInt("max-retries")
+		if err := validateMin("max-retries", maxRetries, 0); err != nil {
+			return nil, err
+		}
+		opts = append(opts, app.WithMaxRetries(maxRetries))
+	}
+
+	if cmd.Flags().Changed("timeout") {
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		if timeout < 0 {
+			return nil, &usageError{fmt.Errorf("--timeout: must not be negative, got %s", timeout)}
+		}
+		opts = append(opts, app.WithTimeout(timeout))
+	}
+
+	if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+		opts = append(opts, app.WithProfile(profile))
+	}
+
+	// Read from the root, since 'fizzy use' has --account and --board flags
+	// of its own that select rather than override.
+	if account, _ := cmd.Root().PersistentFlags().GetString("account"); account != "" {
+		opts = append(opts, app.WithAccount(account))
+	}
+	if board, _ := cmd.Root().PersistentFlags().GetString("board"); board != "" {
+		opts = append(opts, app.WithBoard(board))
+	}
+
+	if baseURL, _ := cmd.Flags().GetString("base-url"); baseURL != "" {
+		if _, err := app.ParseBaseURL(baseURL); err != nil {
+			return nil, &usageError{fmt.Errorf("--base-url: %w", err)}
+		}
+		opts = append(opts, app.WithBaseURL(baseURL))
+	}
+	if caCert, _ := cmd.Flags().GetString("ca-cert"); caCert != "" {
+		opts = append(opts, app.WithCACert(caCert))
+	}
+	if insecure, _ := cmd.Flags().GetBool("insecure-skip-verify"); insecure {
+		opts = append(opts, app.WithInsecureSkipVerify())
+	}
+
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+		opts = append(opts, app.WithoutCache())
+	}
+
+	debug, _ := cmd.Flags().GetBool("debug")
+	debugBodies, _ := cmd.Flags().GetBool("debug-body")
+	if debug || debugBodies {
+		opts = append(opts, app.WithDebug(debugBodies))
+	}
+	if logFile, _ := cmd.Flags().GetString("log-file"); logFile != "" {
+		// Left open for the rest of the process, which runs one command.
+		f, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, &usageError{fmt.Errorf("--log-file: %w", err)}
+		}
+		opts = append(opts, app.WithDebugOutput(f))
+	}
+
+	return opts, nil
+}
+
+// promptPassphrase asks for the passphrase encrypted credentials are read
+// with, when there is a terminal to ask on.
+func promptPassphrase() (string, error) {
+	passphrase, err := ui.PromptPassword("Passphrase for Fizzy credentials: ")
+	if errors.Is(err, ui.ErrNotTerminal) {
+		return "", config.ErrPassphraseRequired
+	}
+	return passphrase, err
+}
+
+// commandStarted is set once the command line has been validated, so any
+// error reported before that is a usage error.
+var commandStarted bool
+
 func Execute() {
-	err := rootCmd.Execute()
-	if err != nil {
-		os.Exit(1)
+	// Cancel the command context on Ctrl-C so in-flight requests stop.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	interrupted := ctx.Err() != nil
+	stop()
+	if err == nil {
+		return
+	}
+	if interrupted {
+		fmt.Fprintln(os.Stderr, "Interrupted")
+		os.Exit(exitInterrupted)
 	}
+
+	if !commandStarted {
+		err = &usageError{err}
+	}
+	// Say why there's no client when the token couldn't be loaded.
+	if a := app.FromContext(cmd.Context()); errors.Is(err, errNoClient) && a != nil && a.AuthErr != nil {
+		err = fmt.Errorf("%w: %v", errNoClient, a.AuthErr)
+	}
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprintf(os.Stderr, "Run '%s --help' for usage.\n", cmd.CommandPath())
+	}
+	os.Exit(exitCode(err))
 }
 
 func init() {
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.fizzy-cli.yaml)")
 
+	rootCmd.PersistentFlags().StringP("output", "o", string(ui.FormatText), "Output format: text, json, yaml, csv, tsv or markdown")
+	rootCmd.PersistentFlags().String("profile", "", "Profile to use from the config file (env: FIZZY_PROFILE)")
+	rootCmd.PersistentFlags().String("account", "", "Account slug to use for this command instead of the selected one (env: FIZZY_ACCOUNT)")
+	rootCmd.PersistentFlags().String("board", "", "Board ID to use for this command instead of the selected one (env: FIZZY_BOARD)")
+	rootCmd.PersistentFlags().String("base-url", "", "API base URL of a self-hosted Fizzy instance (env: FIZZY_BASE_URL, config: base_url)")
+	rootCmd.PersistentFlags().String("ca-cert", "", "PEM file of CA certificates to trust, for instances behind a private CA (config: ca_cert)")
+	rootCmd.PersistentFlags().Bool("insecure-skip-verify", false, "Don't verify TLS certificates, for development instances only (config: insecure_skip_verify)")
+	rootCmd.PersistentFlags().Int("max-retries", app.DefaultMaxRetries, "Retries for rate-limited (429) and failed (5xx) requests, 0 disables (config: max_retries)")
+	rootCmd.PersistentFlags().Duration("timeout", app.DefaultTimeout, "Time limit for each API call, retries included, e.g. 10s or 2m (0 disables)")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Don't use or update the HTTP response cache")
+	rootCmd.PersistentFlags().Bool("debug", false, "Log HTTP requests and responses to stderr, with credentials redacted (env: FIZZY_DEBUG=1)")
+	rootCmd.PersistentFlags().Bool("debug-body", false, "Like --debug, also logging request and response bodies (env: FIZZY_DEBUG=body)")
+	rootCmd.PersistentFlags().String("log-file", "", "Append the debug log to this file instead of stderr")
+	rootCmd.RegisterFlagCompletionFunc("board", completeBoardIDs)
+
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	rootCmd.SetVersionTemplate(fmt.Sprintf("fizzy-cli v%s\n", Version))
 }
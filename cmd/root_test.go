@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// selfHostedEnv sets up a fresh HOME with a selected account and a token in
+// FIZZY_ACCESS_TOKEN, for app.New.
+func selfHostedEnv(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("FIZZY_ACCESS_TOKEN", "test-token")
+	t.Setenv("FIZZY_BASE_URL", "")
+	t.Setenv("FIZZY_PROFILE", "")
+	t.Setenv("FIZZY_ACCOUNT", "")
+	t.Setenv("FIZZY_BOARD", "")
+	if err := (&config.Config{SelectedAccount: "/test-account"}).Save(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func tagServer() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Tag{{ID: "tag-1", Title: "bug"}})
+	})
+}
+
+func listTagsWith(t *testing.T, opts ...app.Option) error {
+	a, err := app.New("test", append(opts, app.WithoutCache(), app.WithMaxRetries(0))...)
+	if err != nil {
+		return err
+	}
+	cmd := &cobra.Command{}
+	addListOutputFlags(cmd)
+	cmd.SetContext(a.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+	return handleListTags(cmd)
+}
+
+func TestBaseURLFromEnv(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		tagServer().ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_BASE_URL", server.URL+"/")
+
+	if err := listTagsWith(t); err != nil {
+		t.Fatalf("listing tags failed: %v", err)
+	}
+	if requested != "/test-account/tags" {
+		t.Errorf("expected a request to the self-hosted instance, got %q", requested)
+	}
+}
+
+func TestBaseURLFlagOverridesEnv(t *testing.T) {
+	server := httptest.NewServer(tagServer())
+	defer server.Close()
+
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_BASE_URL", "http://127.0.0.1:1")
+
+	if err := listTagsWith(t, app.WithBaseURL(server.URL)); err != nil {
+		t.Fatalf("listing tags failed: %v", err)
+	}
+}
+
+func TestInvalidBaseURL(t *testing.T) {
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_BASE_URL", "fizzy.example.com")
+
+	if _, err := app.New("test"); err == nil {
+		t.Error("expected an error for a base URL without a scheme")
+	}
+}
+
+func TestCACert(t *testing.T) {
+	server := httptest.NewTLSServer(tagServer())
+	defer server.Close()
+
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_BASE_URL", server.URL)
+
+	if err := listTagsWith(t); err == nil {
+		t.Fatal("expected the test server's certificate to be rejected")
+	}
+
+	caCert := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caCert, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := listTagsWith(t, app.WithCACert(caCert)); err != nil {
+		t.Errorf("expected the certificate to be trusted with --ca-cert, got %v", err)
+	}
+}
+
+func TestInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(tagServer())
+	defer server.Close()
+
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_BASE_URL", server.URL)
+
+	if err := listTagsWith(t, app.WithInsecureSkipVerify()); err != nil {
+		t.Errorf("expected verification to be skipped, got %v", err)
+	}
+}
+
+func TestAccountFromEnv(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		tagServer().ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_ACCOUNT", "other-account")
+
+	if err := listTagsWith(t, app.WithBaseURL(server.URL)); err != nil {
+		t.Fatalf("listing tags failed: %v", err)
+	}
+	if requested != "/other-account/tags" {
+		t.Errorf("expected FIZZY_ACCOUNT to override the selected account, got %q", requested)
+	}
+}
+
+func TestAccountFlagOverridesEnv(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		tagServer().ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	selfHostedEnv(t)
+	t.Setenv("FIZZY_ACCOUNT", "/env-account")
+
+	if err := listTagsWith(t, app.WithBaseURL(server.URL), app.WithAccount("/flag-account")); err != nil {
+		t.Fatalf("listing tags failed: %v", err)
+	}
+	if requested != "/flag-account/tags" {
+		t.Errorf("expected --account to override FIZZY_ACCOUNT, got %q", requested)
+	}
+}
+
+func TestBoardPrecedence(t *testing.T) {
+	selfHostedEnv(t)
+	if err := (&config.Config{SelectedAccount: "/test-account", SelectedBoard: "config-board"}).Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, env, flag, account, want string
+	}{
+		{name: "config", want: "config-board"},
+		{name: "env", env: "env-board", want: "env-board"},
+		{name: "flag", env: "env-board", flag: "flag-board", want: "flag-board"},
+		{name: "same account", account: "test-account", want: "config-board"},
+		{name: "other account", account: "other-account", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("FIZZY_BOARD", tt.env)
+			t.Setenv("FIZZY_ACCOUNT", tt.account)
+			var opts []app.Option
+			if tt.flag != "" {
+				opts = append(opts, app.WithBoard(tt.flag))
+			}
+
+			a, err := app.New("test", opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.SelectedBoard(); got != tt.want {
+				t.Errorf("expected board %q, got %q", tt.want, got)
+			}
+			saved, err := config.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved.SelectedBoard != "config-board" {
+				t.Errorf("expected the config to be left alone, got %q", saved.SelectedBoard)
+			}
+		})
+	}
+}
+
+func TestLocalConfigPrecedence(t *testing.T) {
+	selfHostedEnv(t)
+	if err := (&config.Config{SelectedAccount: "/test-account", SelectedBoard: "config-board"}).Save(); err != nil {
+		t.Fatal(err)
+	}
+	repo := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(repo, ".fizzy"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, ".fizzy", "config"), []byte(`{"account": "repo-account", "board": "repo-board"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sub := filepath.Join(repo, "src", "pkg")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(sub)
+
+	a, err := app.New("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.SelectedAccount() != "/repo-account" || a.SelectedBoard() != "repo-board" {
+		t.Errorf("expected the local config's selection, got %q and %q", a.SelectedAccount(), a.SelectedBoard())
+	}
+
+	t.Setenv("FIZZY_BOARD", "env-board")
+	if a, err = app.New("test"); err != nil {
+		t.Fatal(err)
+	}
+	if a.SelectedBoard() != "env-board" {
+		t.Errorf("expected FIZZY_BOARD to override the local config, got %q", a.SelectedBoard())
+	}
+}
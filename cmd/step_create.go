@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,34 +12,34 @@ var stepCreateCmd = &cobra.Command{
 	Short: "Create a new step",
 	Long:  `Create a new step (to-do item) on a card`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateStep(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateStep(cmd, args[0])
 	},
 }
 
 func handleCreateStep(cmd *cobra.Command, cardNumber string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	content, _ := cmd.Flags().GetString("content")
 	completed, _ := cmd.Flags().GetBool("completed")
 
-	_, err = a.Client.CreateCardStep(context.Background(), cardNum, content, completed)
+	step, err := a.Client.CreateCardStep(cmd.Context(), cardNum, content, completed)
 	if err != nil {
 		return fmt.Errorf("creating step: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Step created successfully\n")
-	return nil
+	return printResult(cmd, step, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Step created successfully\n")
+		return nil
+	})
 }
 
 func init() {
@@ -49,5 +47,6 @@ func init() {
 	stepCreateCmd.MarkFlagRequired("content")
 	stepCreateCmd.Flags().BoolP("completed", "d", false, "Mark step as completed")
 
+	stepCreateCmd.ValidArgsFunction = completeArgs(completeCards)
 	stepCmd.AddCommand(stepCreateCmd)
 }
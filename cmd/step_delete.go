@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,25 +12,23 @@ var stepDeleteCmd = &cobra.Command{
 	Short: "Delete a step",
 	Long:  `Delete a step from a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteStep(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteStep(cmd, args[0], args[1])
 	},
 }
 
 func handleDeleteStep(cmd *cobra.Command, cardNumber, stepID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err = a.Client.DeleteCardStep(context.Background(), cardNum, stepID)
+	err = a.Client.DeleteCardStep(cmd.Context(), cardNum, stepID)
 	if err != nil {
 		return fmt.Errorf("deleting step: %w", err)
 	}
@@ -42,5 +38,6 @@ func handleDeleteStep(cmd *cobra.Command, cardNumber, stepID string) error {
 }
 
 func init() {
+	stepDeleteCmd.ValidArgsFunction = completeArgs(completeCards)
 	stepCmd.AddCommand(stepDeleteCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
@@ -15,32 +13,33 @@ var stepShowCmd = &cobra.Command{
 	Short: "Show step details",
 	Long:  `Retrieve and display a single step on a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowStep(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowStep(cmd, args[0], args[1])
 	},
 }
 
 func handleShowStep(cmd *cobra.Command, cardNumber, stepID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	step, err := a.Client.GetCardStep(context.Background(), cardNum, stepID)
+	step, err := a.Client.GetCardStep(cmd.Context(), cardNum, stepID)
 	if err != nil {
 		return fmt.Errorf("fetching step: %w", err)
 	}
 
-	return ui.DisplayStep(cmd.OutOrStdout(), step)
+	return printResult(cmd, step, func() error {
+		return ui.DisplayStep(cmd.OutOrStdout(), step)
+	})
 }
 
 func init() {
+	stepShowCmd.ValidArgsFunction = completeArgs(completeCards)
 	stepCmd.AddCommand(stepShowCmd)
 }
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
@@ -14,22 +12,20 @@ var stepUpdateCmd = &cobra.Command{
 	Short: "Update an existing step",
 	Long:  `Update the content or completion status of an existing step on a card`,
 	Args:  cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateStep(cmd, args[0], args[1]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateStep(cmd, args[0], args[1])
 	},
 }
 
 func handleUpdateStep(cmd *cobra.Command, cardNumber, stepID string) error {
-	cardNum, err := strconv.Atoi(cardNumber)
+	cardNum, err := parseCardNumber(cmd, cardNumber)
 	if err != nil {
 		return fmt.Errorf("invalid card number: %w", err)
 	}
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	var contentPtr *string
@@ -49,18 +45,21 @@ func handleUpdateStep(cmd *cobra.Command, cardNumber, stepID string) error {
 		return fmt.Errorf("at least one of --content or --completed must be provided")
 	}
 
-	step, err := a.Client.UpdateCardStep(context.Background(), cardNum, stepID, contentPtr, completedPtr)
+	step, err := a.Client.UpdateCardStep(cmd.Context(), cardNum, stepID, contentPtr, completedPtr)
 	if err != nil {
 		return fmt.Errorf("updating step: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Step updated successfully (id: %s)\n", step.ID)
-	return nil
+	return printResult(cmd, step, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Step updated successfully (id: %s)\n", step.ID)
+		return nil
+	})
 }
 
 func init() {
 	stepUpdateCmd.Flags().StringP("content", "c", "", "New step content")
 	stepUpdateCmd.Flags().BoolP("completed", "d", false, "Mark step as completed")
 
+	stepUpdateCmd.ValidArgsFunction = completeArgs(completeCards)
 	stepCmd.AddCommand(stepUpdateCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -12,36 +11,37 @@ var tagListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all tags",
 	Long:  `Retrieve and display all tags in the account`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListTags(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListTags(cmd)
 	},
 }
 
 func handleListTags(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	tags, err := a.Client.GetTags(context.Background())
+	tags, err := a.Client.GetTags(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching tags: %w", err)
 	}
 
-	if len(tags) == 0 {
-		fmt.Println("No tags found")
-		return nil
-	}
+	return printList(cmd, tags, func() error {
+		if len(tags) == 0 {
+			fmt.Println("No tags found")
+			return nil
+		}
 
-	for _, tag := range tags {
-		fmt.Printf("%s\n", tag.Title)
-	}
+		for _, tag := range tags {
+			fmt.Printf("%s\n", tag.Title)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func init() {
+	addListOutputFlags(tagListCmd)
 	tagCmd.AddCommand(tagListCmd)
 }
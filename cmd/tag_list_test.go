@@ -107,3 +107,52 @@ func TestTagListCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+func TestTagListCommandServesNotModifiedFromCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			if got := r.Header.Get("If-None-Match"); got != `"tags-v1"` {
+				t.Errorf("expected If-None-Match \"tags-v1\", got %q", got)
+			}
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"tags-v1"`)
+		json.NewEncoder(w).Encode([]fizzy.Tag{{ID: "tag-123", Title: "bug"}})
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	cache, err := app.NewCacheTransport(nil, "/test-account", "test-token")
+	if err != nil {
+		t.Fatalf("NewCacheTransport failed: %v", err)
+	}
+	client.HTTPClient.Transport = cache
+	testApp := &app.App{Client: client}
+
+	cmd := tagListCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	for i := 0; i < 2; i++ {
+		if err := handleListTags(cmd); err != nil {
+			t.Fatalf("handleListTags failed on run %d: %v", i+1, err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+
+	tags, err := client.GetTags(context.Background())
+	if err != nil {
+		t.Fatalf("GetTags failed: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Title != "bug" {
+		t.Errorf("expected cached tag 'bug', got %+v", tags)
+	}
+}
@@ -0,0 +1,14 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var timezoneCmd = &cobra.Command{
+	Use:   "timezone",
+	Short: "Manage your timezone",
+	Long: `Manage your timezone, which sets when Fizzy sends notification emails and
+which timezone timestamps are shown in`,
+}
+
+func init() {
+	rootCmd.AddCommand(timezoneCmd)
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var timezoneSetCmd = &cobra.Command{
+	Use:   "set [<timezone>]",
+	Short: "Set your timezone",
+	Long: `Set your timezone to an IANA name such as Europe/Lisbon or America/New_York,
+or with --auto to the timezone of this machine.
+
+Example:
+  fizzy timezone set Europe/Lisbon`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleSetTimezone(cmd, args)
+	},
+}
+
+func handleSetTimezone(cmd *cobra.Command, args []string) error {
+	auto, _ := cmd.Flags().GetBool("auto")
+	var name string
+	switch {
+	case auto && len(args) > 0:
+		return &usageError{fmt.Errorf("cannot specify both a timezone and --auto")}
+	case auto:
+		var err error
+		if name, err = localTimezone(); err != nil {
+			return err
+		}
+	case len(args) > 0:
+		name = args[0]
+	default:
+		return &usageError{fmt.Errorf("must specify a timezone or --auto")}
+	}
+
+	loc, err := loadTimezone(name)
+	if err != nil {
+		return err
+	}
+
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return errNoClient
+	}
+
+	if err := a.Client.UpdateMyTimezone(cmd.Context(), name); err != nil {
+		return fmt.Errorf("updating timezone: %w", err)
+	}
+
+	a.Config.Timezone = name
+	if err := a.Config.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	ui.SetTimezone(loc)
+
+	return printResult(cmd, timezoneResult{Timezone: name}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Timezone set to %s\n", name)
+		return nil
+	})
+}
+
+// loadTimezone validates an IANA timezone name.
+func loadTimezone(name string) (*time.Location, error) {
+	// LoadLocation also accepts "" and "Local", which mean nothing to the API.
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" || name == "Local" {
+		return nil, &usageError{fmt.Errorf("invalid timezone '%s', expected an IANA name such as Europe/Lisbon", name)}
+	}
+	return loc, nil
+}
+
+// localTimezone returns the IANA name of this machine's timezone, from TZ or
+// the /etc/localtime link.
+func localTimezone() (string, error) {
+	if tz := strings.TrimPrefix(os.Getenv("TZ"), ":"); tz != "" && !filepath.IsAbs(tz) {
+		return tz, nil
+	}
+	if target, err := filepath.EvalSymlinks("/etc/localtime"); err == nil {
+		if _, name, ok := strings.Cut(target, "zoneinfo/"); ok {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("could not detect the local timezone, pass its name instead, e.g. 'fizzy timezone set Europe/Lisbon'")
+}
+
+func init() {
+	timezoneSetCmd.Flags().Bool("auto", false, "Use this machine's timezone")
+	timezoneCmd.AddCommand(timezoneSetCmd)
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newTimezoneSetCmd(args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("auto", false, "")
+	cmd.ParseFlags(args)
+	return cmd
+}
+
+func timezoneServer(t *testing.T, want string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my/timezone" {
+			t.Errorf("expected /my/timezone, got %s", r.URL.Path)
+		}
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["timezone_name"] != want {
+			t.Errorf("expected timezone_name %s, got %s", want, body["timezone_name"])
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+func TestTimezoneSetCommand(t *testing.T) {
+	server := timezoneServer(t, "Europe/Lisbon")
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Cleanup(func() { ui.SetTimezone(nil) })
+
+	cfg := &config.Config{}
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token"), Config: cfg}
+
+	cmd := newTimezoneSetCmd()
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleSetTimezone(cmd, []string{"Europe/Lisbon"}); err != nil {
+		t.Fatalf("handleSetTimezone failed: %v", err)
+	}
+	if out.String() != "✓ Timezone set to Europe/Lisbon\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if saved.Timezone != "Europe/Lisbon" {
+		t.Errorf("expected timezone to be saved, got %q", saved.Timezone)
+	}
+
+	// Lisbon is on summer time (UTC+1) in July.
+	if got := ui.FormatTime("2025-07-01T12:00:00Z"); got != "2025-07-01 13:00:00" {
+		t.Errorf("expected timestamps in the new timezone, got %s", got)
+	}
+}
+
+func TestTimezoneSetCommandAuto(t *testing.T) {
+	server := timezoneServer(t, "America/New_York")
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TZ", "America/New_York")
+	t.Cleanup(func() { ui.SetTimezone(nil) })
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token"), Config: &config.Config{}}
+
+	cmd := newTimezoneSetCmd("--auto")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleSetTimezone(cmd, nil); err != nil {
+		t.Fatalf("handleSetTimezone failed: %v", err)
+	}
+	if testApp.Config.Timezone != "America/New_York" {
+		t.Errorf("expected the detected timezone, got %q", testApp.Config.Timezone)
+	}
+}
+
+func TestTimezoneSetCommandInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		flag []string
+	}{
+		{"unknown zone", []string{"Mars/Olympus_Mons"}, nil},
+		{"local", []string{"Local"}, nil},
+		{"no zone", nil, nil},
+		{"zone and auto", []string{"Europe/Lisbon"}, []string{"--auto"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("expected no request, got %s %s", r.Method, r.URL.Path)
+			}))
+			defer server.Close()
+
+			testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token"), Config: &config.Config{}}
+
+			cmd := newTimezoneSetCmd(tt.flag...)
+			cmd.SetContext(testApp.ToContext(context.Background()))
+
+			err := handleSetTimezone(cmd, tt.args)
+			if code := exitCode(err); code != exitUsage {
+				t.Errorf("expected usage error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadTimezone(t *testing.T) {
+	loc, err := loadTimezone("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("loadTimezone failed: %v", err)
+	}
+	if got := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).In(loc).Hour(); got != 9 {
+		t.Errorf("expected UTC+9, got hour %d", got)
+	}
+}
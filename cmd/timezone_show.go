@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+var timezoneShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show your timezone",
+	Long:  `Show the timezone last set with 'fizzy timezone set'. The API doesn't report it, so a timezone set elsewhere isn't shown.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowTimezone(cmd)
+	},
+}
+
+// timezoneResult is what timezone show and set print with --output.
+type timezoneResult struct {
+	Timezone string `json:"timezone"`
+}
+
+func handleShowTimezone(cmd *cobra.Command) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+
+	tz := a.Config.Timezone
+	return printResult(cmd, timezoneResult{Timezone: tz}, func() error {
+		if tz == "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "No timezone set, timestamps are shown as the API returns them\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "Set one with 'fizzy timezone set <name>' or 'fizzy timezone set --auto'\n")
+			return nil
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Timezone: %s\n", tz)
+		if loc, err := time.LoadLocation(tz); err == nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Current time there: %s\n", time.Now().In(loc).Format("2006-01-02 15:04 MST"))
+		}
+		return nil
+	})
+}
+
+func init() {
+	timezoneCmd.AddCommand(timezoneShowCmd)
+}
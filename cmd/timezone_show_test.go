@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func TestTimezoneShowCommand(t *testing.T) {
+	testApp := &app.App{Config: &config.Config{Timezone: "Europe/Lisbon"}}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("output", "o", "json", "")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleShowTimezone(cmd); err != nil {
+		t.Fatalf("handleShowTimezone failed: %v", err)
+	}
+	if strings.TrimSpace(out.String()) != "{\n  \"timezone\": \"Europe/Lisbon\"\n}" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestTimezoneShowCommandNotSet(t *testing.T) {
+	testApp := &app.App{Config: &config.Config{}}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleShowTimezone(cmd); err != nil {
+		t.Fatalf("handleShowTimezone failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "No timezone set") {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -13,36 +12,39 @@ var tokenCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a personal access token",
 	Long:  `Create a new personal access token. The token value is shown once and cannot be retrieved again.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateToken(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateToken(cmd)
 	},
 }
 
 func handleCreateToken(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	description, _ := cmd.Flags().GetString("description")
 	permission, _ := cmd.Flags().GetString("permission")
+	if err := validateOneOf("permission", permission, tokenPermissions); err != nil {
+		return err
+	}
 
 	payload := fizzy.CreateAccessTokenPayload{
 		Description: description,
 		Permission:  permission,
 	}
 
-	token, err := a.Client.CreateAccessToken(context.Background(), payload)
+	token, err := a.Client.CreateAccessToken(cmd.Context(), payload)
 	if err != nil {
 		return fmt.Errorf("creating access token: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Token created (description: %s, permission: %s)\n", token.Description, token.Permission)
-	fmt.Fprintf(cmd.OutOrStdout(), "Token: %s\n", token.Token)
-	fmt.Fprintf(cmd.OutOrStdout(), "Save this value now — it cannot be retrieved again.\n")
-	return nil
+	return printResult(cmd, token, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Token created (description: %s, permission: %s)\n", token.Description, token.Permission)
+		fmt.Fprintf(cmd.OutOrStdout(), "Token: %s\n", token.Token)
+		fmt.Fprintf(cmd.OutOrStdout(), "Save this value now — it cannot be retrieved again.\n")
+		return nil
+	})
 }
 
 func init() {
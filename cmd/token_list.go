@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List personal access tokens",
+	Long:  `List your personal access tokens with their description, permission and creation date. Token values are never shown.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListTokens(cmd)
+	},
+}
+
+func handleListTokens(cmd *cobra.Command) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return errNoClient
+	}
+
+	tokens, err := app.ListAccessTokens(cmd.Context(), a.Client)
+	if err != nil {
+		return fmt.Errorf("fetching access tokens: %w", err)
+	}
+
+	return printList(cmd, tokens, func() error {
+		if len(tokens) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No access tokens found")
+			return nil
+		}
+		return ui.DisplayAccessTokens(cmd.OutOrStdout(), tokens)
+	})
+}
+
+func init() {
+	addListOutputFlags(tokenListCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestTokenListCommand(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my/access_tokens" {
+			t.Errorf("expected /my/access_tokens, got %s", r.URL.Path)
+		}
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer test-token" {
+			t.Errorf("expected Bearer test-token, got %s", auth)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<`+server.URL+`/my/access_tokens?page=2>; rel="next"`)
+			json.NewEncoder(w).Encode([]app.AccessToken{
+				{ID: "tok-1", Description: "Fizzy CLI", Permission: "write", CreatedAt: "2025-12-05T19:38:48Z"},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode([]app.AccessToken{
+			{ID: "tok-2", Description: "CI", Permission: "read", CreatedAt: "2025-12-06T10:00:00Z"},
+		})
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	testApp := &app.App{Client: client}
+
+	cmd := &cobra.Command{}
+	addListOutputFlags(cmd)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--fields", "id,description,permission"})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleListTokens(cmd); err != nil {
+		t.Fatalf("handleListTokens failed: %v", err)
+	}
+
+	for _, want := range []string{"tok-1", "Fizzy CLI", "write", "tok-2", "CI", "read"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestTokenListCommandNoClient(t *testing.T) {
+	testApp := &app.App{}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleListTokens(cmd); err != errNoClient {
+		t.Errorf("expected errNoClient, got %v", err)
+	}
+}
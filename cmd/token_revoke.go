@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <token_id>",
+	Short: "Revoke a personal access token",
+	Long:  `Revoke a personal access token, which stops working immediately. Find token IDs with 'fizzy token list'.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleRevokeToken(cmd, args[0])
+	},
+}
+
+func handleRevokeToken(cmd *cobra.Command, tokenID string) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return errNoClient
+	}
+
+	if err := app.DeleteAccessToken(cmd.Context(), a.Client, tokenID); err != nil {
+		return fmt.Errorf("revoking access token: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Token '%s' revoked successfully\n", tokenID)
+	return nil
+}
+
+func init() {
+	tokenCmd.AddCommand(tokenRevokeCmd)
+}
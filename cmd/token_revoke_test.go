@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestTokenRevokeCommand(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my/access_tokens/tok-1" {
+			t.Errorf("expected /my/access_tokens/tok-1, got %s", r.URL.Path)
+		}
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	testApp := &app.App{Client: client}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleRevokeToken(cmd, "tok-1"); err != nil {
+		t.Fatalf("handleRevokeToken failed: %v", err)
+	}
+	if out.String() != "✓ Token 'tok-1' revoked successfully\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestTokenRevokeCommandNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Not Found"))
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	testApp := &app.App{Client: client}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleRevokeToken(cmd, "missing")
+	if err == nil {
+		t.Fatal("expected error for missing token")
+	}
+	if code := exitCode(err); code != exitNotFound {
+		t.Errorf("expected exit code %d, got %d", exitNotFound, code)
+	}
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/spf13/cobra"
+)
+
+var tokenRotateCmd = &cobra.Command{
+	Use:   "rotate [token_id]",
+	Short: "Replace the access token in use with a new one",
+	Long: `Replace the access token in use with a new one with the same description and
+permission. The new token is saved in place of the old one and checked
+against the API before the old one is revoked. If the check fails, the new
+token is revoked instead and the old one kept.
+
+The API doesn't tell which token is in use, so when you have more than one,
+pass the ID of the one in use from 'fizzy token list'. A token with another
+permission than the one in use is refused.
+
+When the token comes from FIZZY_ACCESS_TOKEN the new one is printed instead,
+for you to update the variable with.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var tokenID string
+		if len(args) == 1 {
+			tokenID = args[0]
+		}
+		return handleRotateToken(cmd, tokenID)
+	},
+}
+
+func handleRotateToken(cmd *cobra.Command, tokenID string) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return errNoClient
+	}
+
+	tokens, err := app.ListAccessTokens(cmd.Context(), a.Client)
+	if err != nil {
+		return fmt.Errorf("fetching access tokens: %w", err)
+	}
+	old, err := tokenInUse(tokens, tokenID, a.Permission)
+	if err != nil {
+		return err
+	}
+
+	token, err := a.Client.CreateAccessToken(cmd.Context(), fizzy.CreateAccessTokenPayload{
+		Description: old.Description,
+		Permission:  old.Permission,
+	})
+	if err != nil {
+		return fmt.Errorf("creating access token: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Created %s access token %s (description: %s)\n", token.Permission, app.RedactToken(token.Token), token.Description)
+
+	if !a.TokenFromEnv {
+		if err := a.Credentials.Store(a.Config.Credential(), token.Token); err != nil {
+			return fmt.Errorf("saving access token: %w", err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Saved it to %s\n", a.Credentials.Location())
+		a.Config.SetTokenPermission(token.Permission)
+		if err := a.Config.Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+	}
+
+	client, err := a.NewClient(a.SelectedAccount(), token.Token)
+	if err != nil {
+		return fmt.Errorf("creating API client: %w", err)
+	}
+	if _, err := client.GetMyIdentity(cmd.Context()); err != nil {
+		return undoRotation(cmd, a, tokens, fmt.Errorf("verifying new access token: %w", err))
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Verified the new token\n")
+
+	if err := app.DeleteAccessToken(cmd.Context(), client, old.ID); err != nil {
+		return fmt.Errorf("revoking old access token: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Revoked old token '%s'\n", old.ID)
+
+	if a.TokenFromEnv {
+		fmt.Fprintf(cmd.OutOrStdout(), "\nFIZZY_ACCESS_TOKEN still holds the revoked token. Update it to:\n%s\n", token.Token)
+		fmt.Fprintf(cmd.OutOrStdout(), "Save this value now — it cannot be retrieved again.\n")
+	}
+	return nil
+}
+
+// tokenInUse returns the token of tokens that is in use: the one with ID id,
+// or the only one there is when id is empty. The API doesn't say which token
+// a request was made with, so a token with another permission than the one
+// in use is the most that can be told apart.
+func tokenInUse(tokens []app.AccessToken, id, permission string) (*app.AccessToken, error) {
+	if id == "" {
+		if len(tokens) != 1 {
+			return nil, &usageError{fmt.Errorf("can't tell which of your %d access tokens is in use, pass its ID (see 'fizzy token list')", len(tokens))}
+		}
+		return &tokens[0], nil
+	}
+
+	for i := range tokens {
+		if tokens[i].ID != id {
+			continue
+		}
+		if permission != "" && tokens[i].Permission != permission {
+			return nil, &usageError{fmt.Errorf("access token '%s' is a %s token, but the one in use is a %s token; only the token in use can be rotated", id, tokens[i].Permission, permission)}
+		}
+		return &tokens[i], nil
+	}
+	return nil, &statusError{http.StatusNotFound, fmt.Sprintf("access token '%s' not found", id)}
+}
+
+// undoRotation revokes the token created by a rotation that failed with err,
+// found as the one missing from tokens, and puts the old token back.
+func undoRotation(cmd *cobra.Command, a *app.App, tokens []app.AccessToken, err error) error {
+	if !a.TokenFromEnv {
+		if storeErr := a.Credentials.Store(a.Config.Credential(), a.Client.AccessToken); storeErr != nil {
+			return fmt.Errorf("%w; restoring the old access token failed, log in again: %v", err, storeErr)
+		}
+		a.Config.SetTokenPermission(a.Permission)
+		if saveErr := a.Config.Save(); saveErr != nil {
+			return fmt.Errorf("%w; saving config: %v", err, saveErr)
+		}
+	}
+
+	now, listErr := app.ListAccessTokens(cmd.Context(), a.Client)
+	if listErr != nil {
+		return fmt.Errorf("%w; old token kept, but the new one couldn't be revoked, see 'fizzy token list': %v", err, listErr)
+	}
+	known := map[string]bool{}
+	for _, t := range tokens {
+		known[t.ID] = true
+	}
+	var created []string
+	for _, t := range now {
+		if !known[t.ID] {
+			created = append(created, t.ID)
+		}
+	}
+	if len(created) != 1 {
+		return fmt.Errorf("%w; old token kept, but the new one couldn't be told apart to revoke it, see 'fizzy token list'", err)
+	}
+	if revokeErr := app.DeleteAccessToken(cmd.Context(), a.Client, created[0]); revokeErr != nil {
+		return fmt.Errorf("%w; old token kept, but revoking the new token '%s' failed: %v", err, created[0], revokeErr)
+	}
+	return fmt.Errorf("%w; new token revoked, old token kept", err)
+}
+
+func init() {
+	tokenCmd.AddCommand(tokenRotateCmd)
+}
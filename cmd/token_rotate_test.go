@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+	"github.com/rogeriopvl/fizzy-cli/internal/config"
+	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestTokenRotateCommand(t *testing.T) {
+	var steps []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		steps = append(steps, r.Method+" "+r.URL.Path+" "+auth)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/my/access_tokens":
+			json.NewEncoder(w).Encode([]app.AccessToken{
+				{ID: "tok-1", Description: "Laptop", Permission: "read"},
+				{ID: "tok-2", Description: "CI", Permission: "write"},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/test-account/my/access_tokens":
+			var body map[string]fizzy.CreateAccessTokenPayload
+			json.NewDecoder(r.Body).Decode(&body)
+			if got := body["access_token"]; got.Description != "Laptop" || got.Permission != "read" {
+				t.Errorf("expected the old token's description and permission, got %+v", got)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(fizzy.PersonalAccessToken{Token: "new-token", Description: "Laptop", Permission: "read"})
+		case r.Method == http.MethodGet && r.URL.Path == "/my/identity":
+			json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{})
+		case r.Method == http.MethodDelete && r.URL.Path == "/my/access_tokens/tok-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+	t.Setenv("FIZZY_PASSPHRASE", "")
+
+	cfg := &config.Config{SelectedAccount: "/test-account"}
+	store := config.NewCredentialStore(cfg, "app.fizzy.do")
+	if err := store.Store(config.DefaultCredential, "test-token"); err != nil {
+		t.Fatal(err)
+	}
+	testApp := &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Config:      cfg,
+		BaseURL:     server.URL,
+		Credentials: store,
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := handleRotateToken(cmd, "tok-1"); err != nil {
+		t.Fatalf("handleRotateToken failed: %v", err)
+	}
+
+	want := []string{
+		"GET /my/access_tokens test-token",
+		"POST /test-account/my/access_tokens test-token",
+		"GET /my/identity new-token",
+		"DELETE /my/access_tokens/tok-1 new-token",
+	}
+	if strings.Join(steps, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected requests:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(steps, "\n"))
+	}
+
+	if token, _ := store.Get(config.DefaultCredential); token != "new-token" {
+		t.Errorf("expected the new token to be stored, got %q", token)
+	}
+	if strings.Contains(out.String(), "new-token") {
+		t.Errorf("expected token to be redacted in output, got:\n%s", out.String())
+	}
+}
+
+func TestTokenRotateCommandVerifyFails(t *testing.T) {
+	var created bool
+	var revoked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/my/access_tokens":
+			tokens := []app.AccessToken{{ID: "tok-1", Description: "Laptop", Permission: "write"}}
+			if created {
+				tokens = append(tokens, app.AccessToken{ID: "tok-2", Description: "Laptop", Permission: "write"})
+			}
+			json.NewEncoder(w).Encode(tokens)
+		case r.Method == http.MethodPost:
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(fizzy.PersonalAccessToken{Token: "new-token", Description: "Laptop", Permission: "write"})
+		case r.URL.Path == "/my/identity":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Method == http.MethodDelete:
+			if auth := r.Header.Get("Authorization"); auth != "Bearer test-token" {
+				t.Errorf("expected the new token to be revoked with the old one, got %q", auth)
+			}
+			revoked = append(revoked, strings.TrimPrefix(r.URL.Path, "/my/access_tokens/"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+	t.Setenv("FIZZY_PASSPHRASE", "")
+
+	cfg := &config.Config{SelectedAccount: "/test-account"}
+	store := config.NewCredentialStore(cfg, "app.fizzy.do")
+	testApp := &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Config:      cfg,
+		BaseURL:     server.URL,
+		Credentials: store,
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleRotateToken(cmd, "tok-1"); err == nil {
+		t.Fatal("expected error when the new token doesn't verify")
+	}
+	if strings.Join(revoked, ",") != "tok-2" {
+		t.Errorf("expected only the new token to be revoked, got %v", revoked)
+	}
+	if token, _ := store.Get(config.DefaultCredential); token != "test-token" {
+		t.Errorf("expected the old token to be restored, got %q", token)
+	}
+}
+
+func TestTokenRotateCommandKeepsEncryption(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/my/access_tokens":
+			json.NewEncoder(w).Encode([]app.AccessToken{{ID: "tok-1", Description: "Laptop", Permission: "write"}})
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(fizzy.PersonalAccessToken{Token: "new-token", Description: "Laptop", Permission: "write"})
+		case r.URL.Path == "/my/identity":
+			json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{})
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("FIZZY_ACCESS_TOKEN", "")
+	t.Setenv("FIZZY_PASSPHRASE", "secret")
+
+	cfg := &config.Config{SelectedAccount: "/test-account"}
+	if err := config.NewCredentialStore(cfg, "app.fizzy.do").Store(config.DefaultCredential, "test-token"); err != nil {
+		t.Fatal(err)
+	}
+
+	// As after entering the passphrase at the prompt: it's known, but
+	// FIZZY_PASSPHRASE isn't set, so new tokens aren't encrypted by default.
+	t.Setenv("FIZZY_PASSPHRASE", "")
+	store := config.NewCredentialStore(cfg, "app.fizzy.do")
+	store.Passphrase = func() (string, error) { return "secret", nil }
+
+	testApp := &app.App{
+		Client:      testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Config:      cfg,
+		BaseURL:     server.URL,
+		Credentials: store,
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(&bytes.Buffer{})
+
+	if err := handleRotateToken(cmd, ""); err != nil {
+		t.Fatalf("handleRotateToken failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".config", "fizzy-cli", "credentials.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "new-token") {
+		t.Errorf("expected the new token to be stored encrypted, got:\n%s", data)
+	}
+	if token, _ := store.Get(config.DefaultCredential); token != "new-token" {
+		t.Errorf("expected the new token to be stored, got %q", token)
+	}
+}
+
+func TestTokenRotateCommandNotInUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]app.AccessToken{
+			{ID: "tok-1", Description: "Laptop", Permission: "read"},
+			{ID: "tok-2", Description: "CI", Permission: "write"},
+		})
+	}))
+	defer server.Close()
+
+	testApp := &app.App{
+		Client:     testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Permission: "write",
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleRotateToken(cmd, "tok-1"); exitCode(err) != exitUsage {
+		t.Errorf("expected a usage error for a token with another permission, got %v", err)
+	}
+	if err := handleRotateToken(cmd, ""); exitCode(err) != exitUsage {
+		t.Errorf("expected a usage error without a token ID and several tokens, got %v", err)
+	}
+}
+
+func TestTokenRotateCommandNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]app.AccessToken{})
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	err := handleRotateToken(cmd, "tok-9")
+	if code := exitCode(err); code != exitNotFound {
+		t.Errorf("expected exit code %d, got %d (%v)", exitNotFound, code, err)
+	}
+}
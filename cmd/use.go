@@ -1,9 +1,10 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"os"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/config"
 	"github.com/spf13/cobra"
@@ -12,11 +13,16 @@ import (
 var useCmd = &cobra.Command{
 	Use:   "use",
 	Short: "Set the active board or account",
-	Long:  `Set the active board or account to use for subsequent commands`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUse(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	Long: `Set the active board or account to use for subsequent commands.
+
+Run on a terminal without --board or --account, it asks which board to use.
+
+With --local, the selection is saved to the repository's .fizzy.json (or
+.fizzy/config) instead, found in the working directory or its parents, or
+created in the working directory. It applies to commands run anywhere in
+the repository and takes precedence over the global selection.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUse(cmd)
 	},
 }
 
@@ -24,50 +30,58 @@ func handleUse(cmd *cobra.Command) error {
 	board, _ := cmd.Flags().GetString("board")
 	account, _ := cmd.Flags().GetString("account")
 
-	if board == "" && account == "" {
-		return fmt.Errorf("must specify either --board or --account")
-	}
-
 	if board != "" && account != "" {
 		return fmt.Errorf("cannot specify both --board and --account")
 	}
 
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("loading config: %w", err)
-	}
-
-	if board != "" {
-		a := app.FromContext(cmd.Context())
-		if a == nil || a.Client == nil {
-			return fmt.Errorf("API client not available")
+	local, _ := cmd.Flags().GetBool("local")
+	if !local {
+		// The board is saved to the global config, so it's looked up in the
+		// global config's account, not one from the repository's config.
+		if a := app.FromContext(cmd.Context()); a != nil && a.Client != nil && a.Config != nil && a.Config.SelectedAccount != "" {
+			if err := a.SwitchAccount(a.Config.SelectedAccount); err != nil {
+				return err
+			}
 		}
+	}
 
-		boards, err := a.Client.GetBoards(context.Background(), nil)
-		if err != nil {
-			return fmt.Errorf("fetching boards: %w", err)
-		}
+	var b *fizzy.Board
+	var err error
+	switch {
+	case board != "":
+		b, err = useBoard(cmd, board)
+	case account == "":
+		b, err = pickBoard(cmd, "must specify either --board or --account")
+	}
+	if err != nil {
+		return err
+	}
 
-		var boardID string
-		for _, b := range boards {
-			if b.Name == board {
-				boardID = b.ID
-				break
-			}
-		}
+	if local {
+		return handleUseLocal(cmd, b, account)
+	}
 
-		if boardID == "" {
-			return fmt.Errorf("board '%s' not found", board)
+	// The app's config has the profile in use applied, so the selection is
+	// saved to it.
+	var cfg *config.Config
+	if a := app.FromContext(cmd.Context()); a != nil && a.Config != nil {
+		cfg = a.Config
+	} else {
+		if cfg, err = config.Load(); err != nil {
+			return fmt.Errorf("loading config: %w", err)
 		}
+	}
 
-		cfg.SelectedBoard = boardID
+	if b != nil {
+		cfg.SelectedBoard = b.ID
 		if err := cfg.Save(); err != nil {
 			return fmt.Errorf("saving config: %w", err)
 		}
-		fmt.Printf("Selected board: %s\n", board)
+		fmt.Printf("Selected board: %s\n", b.Name)
 	}
 
 	if account != "" {
+		account = app.AccountSlug(account)
 		cfg.SelectedAccount = account
 		if err := cfg.Save(); err != nil {
 			return fmt.Errorf("saving config: %w", err)
@@ -78,8 +92,57 @@ func handleUse(cmd *cobra.Command) error {
 	return nil
 }
 
+// handleUseLocal saves the selection to the repository's local config.
+func handleUseLocal(cmd *cobra.Command, board *fizzy.Board, account string) error {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Config == nil {
+		return fmt.Errorf("config not available")
+	}
+
+	local := a.Local
+	if local == nil {
+		dir, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("getting working directory: %w", err)
+		}
+		local = config.NewLocal(dir)
+	}
+
+	if board != nil {
+		local.Board = board.ID
+		// The board only exists in the account it was found in.
+		if local.Account == "" {
+			local.Account = a.SelectedAccount()
+		}
+	}
+	if account != "" {
+		local.Account = app.AccountSlug(account)
+	}
+
+	if err := local.Save(); err != nil {
+		return err
+	}
+	if board != nil {
+		fmt.Fprintf(cmd.OutOrStdout(), "Selected board: %s (saved to %s)\n", board.Name, local.Path())
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Selected account: %s (saved to %s)\n", account, local.Path())
+	}
+	return nil
+}
+
+// useBoard returns the board with ID or name board.
+func useBoard(cmd *cobra.Command, board string) (*fizzy.Board, error) {
+	a := app.FromContext(cmd.Context())
+	if a == nil || a.Client == nil {
+		return nil, errNoClient
+	}
+	return a.ResolveBoard(cmd.Context(), board, app.MatchPrefix)
+}
+
 func init() {
 	rootCmd.AddCommand(useCmd)
-	useCmd.Flags().String("board", "", "Board name to use")
+	useCmd.Flags().String("board", "", "Board name or ID to use")
 	useCmd.Flags().String("account", "", "Account slug to use")
+	useCmd.Flags().Bool("local", false, "Save the selection to the repository's .fizzy.json instead of the global config")
+	useCmd.RegisterFlagCompletionFunc("board", completeBoards)
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -28,6 +30,7 @@ func newUseCmd() *cobra.Command {
 	}
 	cmd.Flags().String("board", "", "Board name to use")
 	cmd.Flags().String("account", "", "Account slug to use")
+	cmd.Flags().Bool("local", false, "")
 	return cmd
 }
 
@@ -111,8 +114,8 @@ func TestUseCommandSetAccount(t *testing.T) {
 		t.Fatalf("failed to load config: %v", err)
 	}
 
-	if savedCfg.SelectedAccount != "my-company" {
-		t.Errorf("expected SelectedAccount=my-company, got %s", savedCfg.SelectedAccount)
+	if savedCfg.SelectedAccount != "/my-company" {
+		t.Errorf("expected SelectedAccount=/my-company, got %s", savedCfg.SelectedAccount)
 	}
 }
 
@@ -180,7 +183,117 @@ func TestUseCommandNoFlagsError(t *testing.T) {
 	if err == nil {
 		t.Errorf("expected error when no flags provided")
 	}
-	if err.Error() != "must specify either --board or --account" {
+	if err.Error() != "must specify either --board or --account" || exitCode(err) != exitUsage {
 		t.Errorf("expected 'no flags' error, got %v", err)
 	}
 }
+
+func TestUseCommandSetAccountInProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{
+		SelectedAccount: "top-level",
+		Profiles:        map[string]*config.Profile{"client": {Account: "client-account"}},
+	}
+	if err := cfg.UseProfile("client"); err != nil {
+		t.Fatal(err)
+	}
+	testApp := &app.App{Config: cfg}
+
+	cmd := newUseCmd()
+	cmd.ParseFlags([]string{"--account", "new-account"})
+	cmd.SetContext(testApp.ToContext(context.Background()))
+
+	if err := handleUse(cmd); err != nil {
+		t.Fatalf("handleUse failed: %v", err)
+	}
+
+	savedCfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if savedCfg.SelectedAccount != "top-level" {
+		t.Errorf("expected top-level account to be kept, got %s", savedCfg.SelectedAccount)
+	}
+	if got := savedCfg.Profiles["client"].Account; got != "/new-account" {
+		t.Errorf("expected profile account=/new-account, got %s", got)
+	}
+}
+
+func TestUseCommandLocalBoard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-123", Name: "My Project"}})
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+	repo := t.TempDir()
+	t.Chdir(repo)
+
+	cfg := &config.Config{SelectedAccount: "/test-account", SelectedBoard: "board-456"}
+	testApp := &app.App{
+		Client: testutil.NewTestClient(server.URL, "", "", "test-token"),
+		Config: cfg,
+	}
+
+	cmd := newUseCmd()
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+	cmd.ParseFlags([]string{"--board", "My Project", "--local"})
+
+	if err := handleUse(cmd); err != nil {
+		t.Fatalf("handleUse failed: %v", err)
+	}
+
+	local, err := config.FindLocal(filepath.Join(repo, "sub", "dir"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if local == nil || local.Board != "board-123" || local.Account != "/test-account" {
+		t.Fatalf("expected the board and its account in .fizzy.json, got %+v", local)
+	}
+	if local.Path() != filepath.Join(repo, ".fizzy.json") {
+		t.Errorf("expected .fizzy.json in the working directory, got %s", local.Path())
+	}
+	if saved, _ := config.Load(); saved.SelectedBoard != "" {
+		t.Errorf("expected the global config to be left alone, got %q", saved.SelectedBoard)
+	}
+}
+
+func TestUseCommandBoardInGlobalAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/global-account/boards" {
+			t.Errorf("expected the global account's boards, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-123", Name: "My Project"}})
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := &config.Config{SelectedAccount: "/global-account"}
+	client, err := fizzy.NewClient("/local-account", "test-token", fizzy.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testApp := &app.App{
+		Client:  client,
+		Config:  cfg,
+		BaseURL: server.URL,
+		Token:   "test-token",
+		Account: "/local-account",
+		Local:   &config.LocalConfig{Account: "/local-account"},
+	}
+
+	cmd := newUseCmd()
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+	cmd.ParseFlags([]string{"--board", "My Project"})
+
+	if err := handleUse(cmd); err != nil {
+		t.Fatalf("handleUse failed: %v", err)
+	}
+	if saved, _ := config.Load(); saved.SelectedAccount != "/global-account" || saved.SelectedBoard != "board-123" {
+		t.Errorf("expected the board saved with the global account, got %+v", saved)
+	}
+}
@@ -12,9 +12,12 @@ var userCmd = &cobra.Command{
 
 Use subcommands to list, view, or manage users:
   fizzy user list         List all users
-  fizzy user show <id>    Show user details
-  fizzy user update <id>  Update user settings
-  fizzy user deactivate <id>  Deactivate a user`,
+  fizzy user show <user>    Show user details
+  fizzy user update <user>  Update user settings
+  fizzy user deactivate <user>  Deactivate a user
+
+Users can be given by ID, name, email address, or the start of a name or
+address, e.g. fizzy user show jane@.`,
 }
 
 func init() {
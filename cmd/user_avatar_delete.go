@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -9,24 +8,24 @@ import (
 )
 
 var userAvatarDeleteCmd = &cobra.Command{
-	Use:   "delete <user_id>",
+	Use:   "delete <user>",
 	Short: "Delete a user's avatar",
 	Long:  `Remove the avatar image for a user`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteUserAvatar(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteUserAvatar(cmd, args[0])
 	},
 }
 
 func handleDeleteUserAvatar(cmd *cobra.Command, userID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	if err := a.Client.DeleteUserAvatar(context.Background(), userID); err != nil {
+	if _, err := forUser(cmd, a, userID, app.MatchExact, func(id string) error {
+		return a.Client.DeleteUserAvatar(cmd.Context(), id)
+	}); err != nil {
 		return fmt.Errorf("deleting user avatar: %w", err)
 	}
 
@@ -35,5 +34,6 @@ func handleDeleteUserAvatar(cmd *cobra.Command, userID string) error {
 }
 
 func init() {
+	userAvatarDeleteCmd.ValidArgsFunction = completeArgs(completeUsers)
 	userAvatarCmd.AddCommand(userAvatarDeleteCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -9,24 +8,24 @@ import (
 )
 
 var userDeactivateCmd = &cobra.Command{
-	Use:   "deactivate <user_id>",
+	Use:   "deactivate <user>",
 	Short: "Deactivate a user",
 	Long:  `Deactivate a user. Only account administrators can deactivate users.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeactivateUser(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeactivateUser(cmd, args[0])
 	},
 }
 
 func handleDeactivateUser(cmd *cobra.Command, userID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	err := a.Client.DeactivateUser(context.Background(), userID)
+	userID, err := forUser(cmd, a, userID, app.MatchExact, func(id string) error {
+		return a.Client.DeactivateUser(cmd.Context(), id)
+	})
 	if err != nil {
 		return fmt.Errorf("deactivating user: %w", err)
 	}
@@ -36,5 +35,6 @@ func handleDeactivateUser(cmd *cobra.Command, userID string) error {
 }
 
 func init() {
+	userDeactivateCmd.ValidArgsFunction = completeArgs(completeUsers)
 	userCmd.AddCommand(userDeactivateCmd)
 }
@@ -52,7 +52,7 @@ func TestUserDeactivateCommandNotFound(t *testing.T) {
 	cmd := userDeactivateCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
-	err := handleDeactivateUser(cmd, "nonexistent-user")
+	err := handleDeactivateUser(cmd, "user-404")
 	if err == nil {
 		t.Errorf("expected error for user not found")
 	}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -9,26 +8,26 @@ import (
 )
 
 var userEmailConfirmChangeCmd = &cobra.Command{
-	Use:   "confirm-change <user_id>",
+	Use:   "confirm-change <user>",
 	Short: "Confirm a user email address change",
 	Long:  `Confirm a previously-requested email address change using the token sent to the new address.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleConfirmUserEmailChange(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleConfirmUserEmailChange(cmd, args[0])
 	},
 }
 
 func handleConfirmUserEmailChange(cmd *cobra.Command, userID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	token, _ := cmd.Flags().GetString("token")
 
-	if err := a.Client.ConfirmUserEmailChange(context.Background(), userID, token); err != nil {
+	if _, err := forUser(cmd, a, userID, app.MatchExact, func(id string) error {
+		return a.Client.ConfirmUserEmailChange(cmd.Context(), id, token)
+	}); err != nil {
 		return fmt.Errorf("confirming email change: %w", err)
 	}
 
@@ -40,5 +39,6 @@ func init() {
 	userEmailConfirmChangeCmd.Flags().StringP("token", "t", "", "Confirmation token (required)")
 	userEmailConfirmChangeCmd.MarkFlagRequired("token")
 
+	userEmailConfirmChangeCmd.ValidArgsFunction = completeArgs(completeUsers)
 	userEmailCmd.AddCommand(userEmailConfirmChangeCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -10,27 +9,27 @@ import (
 )
 
 var userEmailRequestChangeCmd = &cobra.Command{
-	Use:   "request-change <user_id>",
+	Use:   "request-change <user>",
 	Short: "Request a user email address change",
 	Long:  `Request an email address change for a user. A confirmation token will be sent to the new address.`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleRequestUserEmailChange(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleRequestUserEmailChange(cmd, args[0])
 	},
 }
 
 func handleRequestUserEmailChange(cmd *cobra.Command, userID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	email, _ := cmd.Flags().GetString("email")
 	payload := fizzy.RequestEmailChangePayload{EmailAddress: email}
 
-	if err := a.Client.RequestUserEmailChange(context.Background(), userID, payload); err != nil {
+	if _, err := forUser(cmd, a, userID, app.MatchExact, func(id string) error {
+		return a.Client.RequestUserEmailChange(cmd.Context(), id, payload)
+	}); err != nil {
 		return fmt.Errorf("requesting email change: %w", err)
 	}
 
@@ -42,5 +41,6 @@ func init() {
 	userEmailRequestChangeCmd.Flags().StringP("email", "e", "", "New email address (required)")
 	userEmailRequestChangeCmd.MarkFlagRequired("email")
 
+	userEmailRequestChangeCmd.ValidArgsFunction = completeArgs(completeUsers)
 	userEmailCmd.AddCommand(userEmailRequestChangeCmd)
 }
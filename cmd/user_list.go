@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,32 +12,32 @@ var userListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all users",
 	Long:  `Retrieve and display all users from the current account`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListUsers(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListUsers(cmd)
 	},
 }
 
 func handleListUsers(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	users, err := a.Client.GetUsers(context.Background())
+	users, err := a.Client.GetUsers(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching users: %w", err)
 	}
 
-	if len(users) == 0 {
-		fmt.Println("No users found")
-		return nil
-	}
-
-	return ui.DisplayUsers(cmd.OutOrStdout(), users)
+	return printList(cmd, users, func() error {
+		if len(users) == 0 {
+			fmt.Println("No users found")
+			return nil
+		}
+		return ui.DisplayUsers(cmd.OutOrStdout(), users)
+	})
 }
 
 func init() {
+	addListOutputFlags(userListCmd)
 	userCmd.AddCommand(userListCmd)
 }
@@ -1,40 +1,45 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var userShowCmd = &cobra.Command{
-	Use:   "show <user_id>",
+	Use:   "show <user>",
 	Short: "Show user details",
 	Long:  `Retrieve and display detailed information about a specific user`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowUser(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowUser(cmd, args[0])
 	},
 }
 
 func handleShowUser(cmd *cobra.Command, userID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	user, err := a.Client.GetUser(context.Background(), userID)
+	var user *fizzy.User
+	_, err := forUser(cmd, a, userID, app.MatchPrefix, func(id string) (err error) {
+		user, err = a.Client.GetUser(cmd.Context(), id)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching user: %w", err)
 	}
 
-	return ui.DisplayUser(cmd.OutOrStdout(), user)
+	return printResult(cmd, user, func() error {
+		return ui.DisplayUser(cmd.OutOrStdout(), user)
+	})
 }
 
 func init() {
+	userShowCmd.ValidArgsFunction = completeArgs(completeUsers)
 	userCmd.AddCommand(userShowCmd)
 }
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestUserShowCommand(t *testing.T) {
@@ -63,7 +65,7 @@ func TestUserShowCommandNotFound(t *testing.T) {
 	cmd := userShowCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
 
-	err := handleShowUser(cmd, "nonexistent-user")
+	err := handleShowUser(cmd, "user-404")
 	if err == nil {
 		t.Errorf("expected error for user not found")
 	}
@@ -108,3 +110,35 @@ func TestUserShowCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+func TestUserShowCommandByEmail(t *testing.T) {
+	users := []fizzy.User{
+		{ID: "user-1", Name: "Jane Doe", Email: "jane@example.com"},
+		{ID: "user-2", Name: "John Doe", Email: "john@example.com"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test-account/users":
+			json.NewEncoder(w).Encode(users)
+		case "/test-account/users/user-1":
+			json.NewEncoder(w).Encode(users[0])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.SetOut(io.Discard)
+
+	for _, query := range []string{"jane@example.com", "JANE@", "jane"} {
+		if err := handleShowUser(cmd, query); err != nil {
+			t.Errorf("handleShowUser(%q) failed: %v", query, err)
+		}
+	}
+	if err := handleShowUser(cmd, "j"); exitCode(err) != exitUsage {
+		t.Errorf("expected 'j' to be ambiguous, got %v", err)
+	}
+}
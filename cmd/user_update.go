@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -10,7 +9,7 @@ import (
 )
 
 var userUpdateCmd = &cobra.Command{
-	Use:   "update <user_id>",
+	Use:   "update <user>",
 	Short: "Update a user",
 	Long: `Update user settings such as name and avatar.
 
@@ -20,13 +19,17 @@ Example:
   fizzy user update user-123 --name "John Doe"
   fizzy user update user-123 --avatar https://example.com/avatar.jpg`,
 	Args: cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateUser(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateUser(cmd, args[0])
 	},
 }
 
+// userUpdateFlags maps the fields of a user update to the flags setting them.
+var userUpdateFlags = map[string]string{
+	"name":   "name",
+	"avatar": "avatar",
+}
+
 func handleUpdateUser(cmd *cobra.Command, userID string) error {
 	if !cmd.Flags().Changed("name") && !cmd.Flags().Changed("avatar") {
 		return fmt.Errorf("at least one flag must be provided (--name or --avatar)")
@@ -34,7 +37,7 @@ func handleUpdateUser(cmd *cobra.Command, userID string) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
 	payload := fizzy.UpdateUserPayload{}
@@ -54,18 +57,29 @@ func handleUpdateUser(cmd *cobra.Command, userID string) error {
 		payload.Avatar = avatar
 	}
 
-	err := a.Client.UpdateUser(context.Background(), userID, payload)
+	userID, err := forUser(cmd, a, userID, app.MatchExact, func(id string) error {
+		return a.Client.UpdateUser(cmd.Context(), id, payload)
+	})
 	if err != nil {
-		return fmt.Errorf("updating user: %w", err)
+		return wrapAPIError("updating user", err, userUpdateFlags)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ User '%s' updated successfully\n", userID)
-	return nil
+	return printFetchedResult(cmd, func() (any, error) {
+		user, err := a.Client.GetUser(cmd.Context(), userID)
+		if err != nil {
+			return nil, fmt.Errorf("fetching user: %w", err)
+		}
+		return user, nil
+	}, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ User '%s' updated successfully\n", userID)
+		return nil
+	})
 }
 
 func init() {
 	userUpdateCmd.Flags().StringP("name", "n", "", "User name")
 	userUpdateCmd.Flags().String("avatar", "", "Avatar URL (e.g., https://example.com/avatar.jpg)")
 
+	userUpdateCmd.ValidArgsFunction = completeArgs(completeUsers)
 	userCmd.AddCommand(userUpdateCmd)
 }
@@ -128,7 +128,7 @@ func TestUserUpdateCommandNotFound(t *testing.T) {
 	cmd.SetContext(testApp.ToContext(context.Background()))
 	cmd.ParseFlags([]string{"--name", "Updated Name"})
 
-	err := handleUpdateUser(cmd, "nonexistent-user")
+	err := handleUpdateUser(cmd, "user-404")
 	if err == nil {
 		t.Errorf("expected error for user not found")
 	}
@@ -160,6 +160,32 @@ func TestUserUpdateCommandAPIError(t *testing.T) {
 	}
 }
 
+func TestUserUpdateCommandValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"avatar": ["must be a JPEG, PNG, GIF, or WebP image"]}`))
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	testApp := &app.App{Client: client}
+
+	cmd := userUpdateCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--avatar", "https://example.com/avatar.txt"})
+
+	err := handleUpdateUser(cmd, "user-123")
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if err.Error() != "updating user: --avatar: must be a JPEG, PNG, GIF, or WebP image" {
+		t.Errorf("expected field error for --avatar, got %v", err)
+	}
+	if code := exitCode(err); code != exitValidation {
+		t.Errorf("expected exit code %d, got %d", exitValidation, code)
+	}
+}
+
 func TestUserUpdateCommandNoClient(t *testing.T) {
 	testApp := &app.App{}
 
@@ -175,3 +201,37 @@ func TestUserUpdateCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+func TestUserUpdateCommandExactName(t *testing.T) {
+	users := []fizzy.User{{ID: "user-1", Name: "Jane Doe", Email: "jane@example.com"}}
+	var updated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/test-account/users":
+			json.NewEncoder(w).Encode(users)
+		case r.Method == http.MethodPut:
+			updated = append(updated, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testApp := &app.App{Client: testutil.NewTestClient(server.URL, "", "", "test-token")}
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("name", "n", "", "User name")
+	cmd.Flags().String("avatar", "", "Avatar")
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--name", "Updated Name"})
+
+	if err := handleUpdateUser(cmd, "jane"); exitCode(err) != exitNotFound {
+		t.Errorf("expected a partial name not to match, got %v", err)
+	}
+	if err := handleUpdateUser(cmd, "Jane Doe"); err != nil {
+		t.Errorf("handleUpdateUser failed: %v", err)
+	}
+	if len(updated) != 1 || updated[0] != "/test-account/users/user-1" {
+		t.Errorf("expected only Jane Doe to be updated, got %v", updated)
+	}
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// The API tends to answer malformed values with a 500 rather than a 422, so
+// flag values are checked before a request is sent. Failures are usage
+// errors naming the offending flag.
+
+var (
+	cardStatuses      = []string{"drafted", "published"}
+	tokenPermissions  = []string{"read", "write"}
+	webhookActions    = []string{"card_assigned", "card_closed", "card_postponed", "card_auto_postponed", "card_board_changed", "card_published", "card_reopened", "card_sent_back_to_triage", "card_triaged", "card_unassigned", "comment_created"}
+	timestampExamples = "e.g. 2025-12-05 or 2025-12-05T19:38:48Z"
+)
+
+// timestampLayouts are the ISO 8601 forms accepted for timestamp flags.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	time.DateOnly,
+}
+
+// validateTimestamp checks that value is an ISO 8601 date or date-time.
+func validateTimestamp(flag, value string) error {
+	for _, layout := range timestampLayouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return nil
+		}
+	}
+	return &usageError{fmt.Errorf("--%s: invalid timestamp '%s', expected ISO 8601 (%s)", flag, value, timestampExamples)}
+}
+
+// validateOneOf checks that value is one of allowed.
+func validateOneOf(flag, value string, allowed []string) error {
+	if slices.Contains(allowed, value) {
+		return nil
+	}
+	return &usageError{fmt.Errorf("--%s: invalid value '%s', must be one of: %s", flag, value, strings.Join(allowed, ", "))}
+}
+
+// validateEachOneOf checks that every value is one of allowed.
+func validateEachOneOf(flag string, values, allowed []string) error {
+	for _, value := range values {
+		if err := validateOneOf(flag, value, allowed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateMin checks that value is at least min.
+func validateMin(flag string, value, min int) error {
+	if value < min {
+		return &usageError{fmt.Errorf("--%s: must be at least %d, got %d", flag, min, value)}
+	}
+	return nil
+}
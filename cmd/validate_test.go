@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestValidateTimestamp(t *testing.T) {
+	valid := []string{
+		"2025-12-05",
+		"2025-12-05T19:38:48Z",
+		"2025-12-05T19:38:48.553Z",
+		"2025-12-05T19:38:48+01:00",
+		"2025-12-05T19:38:48",
+		"2025-12-05T19:38",
+	}
+	for _, value := range valid {
+		if err := validateTimestamp("created-at", value); err != nil {
+			t.Errorf("expected %q to be valid, got %v", value, err)
+		}
+	}
+
+	invalid := []string{"yesterday", "05/12/2025", "2025-13-01", "1733427528"}
+	for _, value := range invalid {
+		err := validateTimestamp("created-at", value)
+		if err == nil {
+			t.Errorf("expected %q to be invalid", value)
+			continue
+		}
+		if exitCode(err) != exitUsage {
+			t.Errorf("expected usage error for %q, got exit code %d", value, exitCode(err))
+		}
+	}
+
+	err := validateTimestamp("last-active-at", "yesterday")
+	expected := "--last-active-at: invalid timestamp 'yesterday', expected ISO 8601 (e.g. 2025-12-05 or 2025-12-05T19:38:48Z)"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestValidateOneOf(t *testing.T) {
+	if err := validateOneOf("permission", "write", tokenPermissions); err != nil {
+		t.Errorf("expected write to be valid, got %v", err)
+	}
+
+	err := validateOneOf("permission", "admin", tokenPermissions)
+	if err == nil {
+		t.Fatal("expected error for invalid permission")
+	}
+	if err.Error() != "--permission: invalid value 'admin', must be one of: read, write" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateEachOneOf(t *testing.T) {
+	if err := validateEachOneOf("actions", []string{"card_published", "comment_created"}, webhookActions); err != nil {
+		t.Errorf("expected actions to be valid, got %v", err)
+	}
+	if err := validateEachOneOf("actions", nil, webhookActions); err != nil {
+		t.Errorf("expected no actions to be valid, got %v", err)
+	}
+
+	err := validateEachOneOf("actions", []string{"card_published", "card.created"}, webhookActions)
+	if err == nil {
+		t.Fatal("expected error for invalid action")
+	}
+	if len(webhookActions) != 11 {
+		t.Errorf("expected 11 documented webhook actions, got %d", len(webhookActions))
+	}
+}
+
+func TestValidateMin(t *testing.T) {
+	if err := validateMin("limit", 0, 0); err != nil {
+		t.Errorf("expected 0 to be valid, got %v", err)
+	}
+
+	err := validateMin("limit", -1, 0)
+	if err == nil {
+		t.Fatal("expected error for negative limit")
+	}
+	if err.Error() != "--limit: must be at least 0, got -1" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
 )
 
@@ -21,3 +24,28 @@ Use subcommands to manage webhooks:
 func init() {
 	rootCmd.AddCommand(webhookCmd)
 }
+
+// webhookBoardID returns the board whose webhooks to manage: --board-id, an
+// ID or name to be resolved with forBoard, or else the board from --board,
+// FIZZY_BOARD or the config.
+func webhookBoardID(cmd *cobra.Command, a *app.App) (string, error) {
+	boardID, _ := cmd.Flags().GetString("board-id")
+	if boardID == "" {
+		boardID = a.SelectedBoard()
+	}
+	if boardID == "" {
+		return "", fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	}
+	return boardID, nil
+}
+
+// forWebhookBoard calls fn with the ID of boardID, from webhookBoardID. It
+// resolves boardID with forBoard only when it came from --board-id: the
+// others are IDs already.
+func forWebhookBoard(cmd *cobra.Command, a *app.App, boardID string, match app.Match, fn func(id string) error) error {
+	if flag, _ := cmd.Flags().GetString("board-id"); flag == "" {
+		return fn(boardID)
+	}
+	_, err := forBoard(cmd, a, boardID, match, fn)
+	return err
+}
@@ -1,9 +1,9 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/spf13/cobra"
 )
@@ -13,38 +13,40 @@ var webhookActivateCmd = &cobra.Command{
 	Short: "Activate a webhook",
 	Long:  `Activate a webhook on a board`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleActivateWebhook(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleActivateWebhook(cmd, args[0])
 	},
 }
 
 func handleActivateWebhook(cmd *cobra.Command, webhookID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
-	_, err := a.Client.ActivateWebhook(context.Background(), boardID, webhookID)
+	var webhook *fizzy.Webhook
+	err = forWebhookBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		webhook, err = a.Client.ActivateWebhook(cmd.Context(), id, webhookID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("activating webhook: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook '%s' activated successfully\n", webhookID)
-	return nil
+	return printResult(cmd, webhook, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook '%s' activated successfully\n", webhookID)
+		return nil
+	})
 }
 
 func init() {
-	webhookActivateCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookActivateCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
+	webhookActivateCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
 
 	webhookCmd.AddCommand(webhookActivateCmd)
 }
@@ -1,8 +1,8 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"strings"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,30 +13,37 @@ var webhookCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new webhook",
 	Long:  `Create a new webhook for a board in Fizzy`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleCreateWebhook(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleCreateWebhook(cmd)
 	},
 }
 
+// webhookCreateFlags maps the fields of a new webhook to the flags setting
+// them.
+var webhookCreateFlags = map[string]string{
+	"name":               "name",
+	"url":                "url",
+	"payload_url":        "url",
+	"subscribed_actions": "actions",
+}
+
 func handleCreateWebhook(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
 	name, _ := cmd.Flags().GetString("name")
 	url, _ := cmd.Flags().GetString("url")
 	actions, _ := cmd.Flags().GetStringSlice("actions")
+	if err := validateEachOneOf("actions", actions, webhookActions); err != nil {
+		return err
+	}
 
 	payload := fizzy.CreateWebhookPayload{
 		Name:              name,
@@ -44,22 +51,29 @@ func handleCreateWebhook(cmd *cobra.Command) error {
 		SubscribedActions: actions,
 	}
 
-	webhook, err := a.Client.CreateWebhook(context.Background(), boardID, payload)
+	var webhook *fizzy.Webhook
+	err = forWebhookBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		webhook, err = a.Client.CreateWebhook(cmd.Context(), id, payload)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("creating webhook: %w", err)
+		return wrapAPIError("creating webhook", err, webhookCreateFlags)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook '%s' created successfully (ID: %s)\n", webhook.Name, webhook.ID)
-	return nil
+	return printResult(cmd, webhook, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook '%s' created successfully (ID: %s)\n", webhook.Name, webhook.ID)
+		return nil
+	})
 }
 
 func init() {
-	webhookCreateCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookCreateCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
 	webhookCreateCmd.Flags().StringP("name", "n", "", "Webhook name (required)")
 	webhookCreateCmd.MarkFlagRequired("name")
 	webhookCreateCmd.Flags().StringP("url", "u", "", "Webhook payload URL (required)")
 	webhookCreateCmd.MarkFlagRequired("url")
-	webhookCreateCmd.Flags().StringSliceP("actions", "a", nil, "Subscribed actions (comma-separated)")
+	webhookCreateCmd.Flags().StringSliceP("actions", "a", nil, fmt.Sprintf("Subscribed actions (comma-separated). Available: %s", strings.Join(webhookActions, ", ")))
+	webhookCreateCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
 
 	webhookCmd.AddCommand(webhookCreateCmd)
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -12,6 +13,7 @@ import (
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/config"
 	"github.com/rogeriopvl/fizzy-cli/internal/testutil"
+	"github.com/spf13/cobra"
 )
 
 func TestWebhookCreateCommandSuccess(t *testing.T) {
@@ -97,8 +99,8 @@ func TestWebhookCreateCommandWithSelectedBoard(t *testing.T) {
 
 func TestWebhookCreateCommandFlagOverridesSelectedBoard(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/test-account/boards/flag-board/webhooks" {
-			t.Errorf("expected /test-account/boards/flag-board/webhooks, got %s", r.URL.Path)
+		if r.URL.Path != "/test-account/boards/flag-board-1/webhooks" {
+			t.Errorf("expected /test-account/boards/flag-board-1/webhooks, got %s", r.URL.Path)
 		}
 
 		w.WriteHeader(http.StatusCreated)
@@ -117,7 +119,7 @@ func TestWebhookCreateCommandFlagOverridesSelectedBoard(t *testing.T) {
 
 	cmd := webhookCreateCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
-	cmd.ParseFlags([]string{"--board-id", "flag-board", "--name", "My Webhook", "--url", "https://example.com/hook"})
+	cmd.ParseFlags([]string{"--board-id", "flag-board-1", "--name", "My Webhook", "--url", "https://example.com/hook"})
 
 	if err := handleCreateWebhook(cmd); err != nil {
 		t.Fatalf("handleCreateWebhook failed: %v", err)
@@ -134,11 +136,11 @@ func TestWebhookCreateCommandWithActions(t *testing.T) {
 		if len(webhookPayload.SubscribedActions) != 2 {
 			t.Errorf("expected 2 actions, got %d", len(webhookPayload.SubscribedActions))
 		}
-		if webhookPayload.SubscribedActions[0] != "card.created" {
-			t.Errorf("expected first action 'card.created', got %s", webhookPayload.SubscribedActions[0])
+		if webhookPayload.SubscribedActions[0] != "card_published" {
+			t.Errorf("expected first action 'card_published', got %s", webhookPayload.SubscribedActions[0])
 		}
-		if webhookPayload.SubscribedActions[1] != "card.updated" {
-			t.Errorf("expected second action 'card.updated', got %s", webhookPayload.SubscribedActions[1])
+		if webhookPayload.SubscribedActions[1] != "card_assigned" {
+			t.Errorf("expected second action 'card_assigned', got %s", webhookPayload.SubscribedActions[1])
 		}
 
 		w.WriteHeader(http.StatusCreated)
@@ -158,7 +160,7 @@ func TestWebhookCreateCommandWithActions(t *testing.T) {
 		"--board-id", "board-123",
 		"--name", "My Webhook",
 		"--url", "https://example.com/hook",
-		"--actions", "card.created,card.updated",
+		"--actions", "card_published,card_assigned",
 	})
 
 	if err := handleCreateWebhook(cmd); err != nil {
@@ -224,3 +226,44 @@ func TestWebhookCreateCommandNoClient(t *testing.T) {
 		t.Errorf("expected 'client not available' error, got %v", err)
 	}
 }
+
+func TestWebhookCreateCommandJSONOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(fizzy.Webhook{
+			ID:            "webhook-456",
+			Name:          "My Webhook",
+			SigningSecret: "secret",
+		})
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "board-123"},
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("board-id", "b", "", "Board ID")
+	cmd.Flags().StringP("name", "n", "", "Webhook name")
+	cmd.Flags().StringP("url", "u", "", "Webhook payload URL")
+	cmd.Flags().StringSliceP("actions", "a", nil, "Subscribed actions")
+	cmd.Flags().StringP("output", "o", "text", "Output format")
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--name", "My Webhook", "--url", "https://example.com/hook", "--output", "json"})
+
+	if err := handleCreateWebhook(cmd); err != nil {
+		t.Fatalf("handleCreateWebhook failed: %v", err)
+	}
+
+	var webhook fizzy.Webhook
+	if err := json.Unmarshal(buf.Bytes(), &webhook); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if webhook.ID != "webhook-456" || webhook.SigningSecret != "secret" {
+		t.Errorf("expected the created webhook, got %+v", webhook)
+	}
+}
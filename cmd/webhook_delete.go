@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,28 +12,25 @@ var webhookDeleteCmd = &cobra.Command{
 	Short: "Delete a webhook",
 	Long:  `Delete a webhook from a board`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleDeleteWebhook(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleDeleteWebhook(cmd, args[0])
 	},
 }
 
 func handleDeleteWebhook(cmd *cobra.Command, webhookID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
-	err := a.Client.DeleteWebhook(context.Background(), boardID, webhookID)
+	err = forWebhookBoard(cmd, a, boardID, app.MatchExact, func(id string) error {
+		return a.Client.DeleteWebhook(cmd.Context(), id, webhookID)
+	})
 	if err != nil {
 		return fmt.Errorf("deleting webhook: %w", err)
 	}
@@ -44,7 +40,8 @@ func handleDeleteWebhook(cmd *cobra.Command, webhookID string) error {
 }
 
 func init() {
-	webhookDeleteCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookDeleteCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
+	webhookDeleteCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
 
 	webhookCmd.AddCommand(webhookDeleteCmd)
 }
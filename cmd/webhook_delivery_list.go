@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -15,48 +14,56 @@ var webhookDeliveryListCmd = &cobra.Command{
 	Short: "List webhook deliveries",
 	Long:  `Retrieve and display delivery attempts for a webhook`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListWebhookDeliveries(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListWebhookDeliveries(cmd, args[0])
 	},
 }
 
 func handleListWebhookDeliveries(cmd *cobra.Command, webhookID string) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
 	opts := &fizzy.ListOptions{}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		opts.Limit = limit
 	}
 
-	deliveries, err := a.Client.GetWebhookDeliveries(context.Background(), boardID, webhookID, opts)
+	var deliveries []fizzy.WebhookDelivery
+	err = forWebhookBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		deliveries, err = a.Client.GetWebhookDeliveries(cmd.Context(), id, webhookID, opts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching webhook deliveries: %w", err)
 	}
 
-	if len(deliveries) == 0 {
-		fmt.Fprintln(cmd.OutOrStdout(), "No deliveries found")
-		return nil
-	}
-
-	return ui.DisplayWebhookDeliveries(cmd.OutOrStdout(), deliveries)
+	return printList(cmd, deliveries, func() error {
+		if len(deliveries) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No deliveries found")
+			return nil
+		}
+		return ui.DisplayWebhookDeliveries(cmd.OutOrStdout(), deliveries)
+	})
 }
 
 func init() {
-	webhookDeliveryListCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookDeliveryListCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
 	webhookDeliveryListCmd.Flags().IntP("limit", "l", 0, "Maximum number of deliveries to return (0 = no limit)")
+	webhookDeliveryListCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
+
+	addListOutputFlags(webhookDeliveryListCmd)
 
 	webhookDeliveryCmd.AddCommand(webhookDeliveryListCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
@@ -14,48 +13,56 @@ var webhookListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all webhooks",
 	Long:  `Retrieve and display all webhooks for a board`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleListWebhooks(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleListWebhooks(cmd)
 	},
 }
 
 func handleListWebhooks(cmd *cobra.Command) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	if err := validateMin("limit", limit, 0); err != nil {
+		return err
+	}
+
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
 	opts := &fizzy.ListOptions{}
-	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+	if limit > 0 {
 		opts.Limit = limit
 	}
 
-	webhooks, err := a.Client.GetWebhooks(context.Background(), boardID, opts)
+	var webhooks []fizzy.Webhook
+	err = forWebhookBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		webhooks, err = a.Client.GetWebhooks(cmd.Context(), id, opts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching webhooks: %w", err)
 	}
 
-	if len(webhooks) == 0 {
-		fmt.Fprintln(cmd.OutOrStdout(), "No webhooks found")
-		return nil
-	}
-
-	return ui.DisplayWebhooks(webhooks)
+	return printList(cmd, webhooks, func() error {
+		if len(webhooks) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No webhooks found")
+			return nil
+		}
+		return ui.DisplayWebhooks(webhooks)
+	})
 }
 
 func init() {
-	webhookListCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookListCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
 	webhookListCmd.Flags().IntP("limit", "l", 0, "Maximum number of webhooks to return (0 = no limit)")
+	webhookListCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
+
+	addListOutputFlags(webhookListCmd)
 
 	webhookCmd.AddCommand(webhookListCmd)
 }
@@ -86,8 +86,8 @@ func TestWebhookListCommandWithSelectedBoard(t *testing.T) {
 
 func TestWebhookListCommandFlagOverridesSelectedBoard(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/test-account/boards/flag-board/webhooks" {
-			t.Errorf("expected /test-account/boards/flag-board/webhooks, got %s", r.URL.Path)
+		if r.URL.Path != "/test-account/boards/flag-board-1/webhooks" {
+			t.Errorf("expected /test-account/boards/flag-board-1/webhooks, got %s", r.URL.Path)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -103,7 +103,34 @@ func TestWebhookListCommandFlagOverridesSelectedBoard(t *testing.T) {
 
 	cmd := webhookListCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
-	cmd.ParseFlags([]string{"--board-id", "flag-board"})
+	cmd.ParseFlags([]string{"--board-id", "flag-board-1"})
+
+	if err := handleListWebhooks(cmd); err != nil {
+		t.Fatalf("handleListWebhooks failed: %v", err)
+	}
+}
+
+func TestWebhookListCommandBoardOverridesSelectedBoard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/boards/override-board/webhooks" {
+			t.Errorf("expected /test-account/boards/override-board/webhooks, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]fizzy.Webhook{})
+	}))
+	defer server.Close()
+
+	client := testutil.NewTestClient(server.URL, "", "", "test-token")
+	testApp := &app.App{
+		Client: client,
+		Config: &config.Config{SelectedBoard: "selected-board"},
+		Board:  "override-board",
+	}
+
+	cmd := webhookListCmd
+	cmd.SetContext(testApp.ToContext(context.Background()))
+	cmd.ParseFlags([]string{"--board-id", ""})
 
 	if err := handleListWebhooks(cmd); err != nil {
 		t.Fatalf("handleListWebhooks failed: %v", err)
@@ -1,9 +1,9 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
+	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
 	"github.com/rogeriopvl/fizzy-cli/internal/ui"
 	"github.com/spf13/cobra"
@@ -14,37 +14,39 @@ var webhookShowCmd = &cobra.Command{
 	Short: "Show webhook details",
 	Long:  `Retrieve and display detailed information about a specific webhook`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleShowWebhook(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleShowWebhook(cmd, args[0])
 	},
 }
 
 func handleShowWebhook(cmd *cobra.Command, webhookID string) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
-	webhook, err := a.Client.GetWebhook(context.Background(), boardID, webhookID)
+	var webhook *fizzy.Webhook
+	err = forWebhookBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		webhook, err = a.Client.GetWebhook(cmd.Context(), id, webhookID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("fetching webhook: %w", err)
 	}
 
-	return ui.DisplayWebhook(cmd.OutOrStdout(), webhook)
+	return printResult(cmd, webhook, func() error {
+		return ui.DisplayWebhook(cmd.OutOrStdout(), webhook)
+	})
 }
 
 func init() {
-	webhookShowCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookShowCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
+	webhookShowCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
 
 	webhookCmd.AddCommand(webhookShowCmd)
 }
@@ -1,8 +1,8 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"strings"
 
 	fizzy "github.com/rogeriopvl/fizzy-go"
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -14,10 +14,8 @@ var webhookUpdateCmd = &cobra.Command{
 	Short: "Update a webhook",
 	Long:  `Update webhook settings such as name and subscribed actions`,
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleUpdateWebhook(cmd, args[0]); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleUpdateWebhook(cmd, args[0])
 	},
 }
 
@@ -28,15 +26,12 @@ func handleUpdateWebhook(cmd *cobra.Command, webhookID string) error {
 
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	boardID, _ := cmd.Flags().GetString("board-id")
-	if boardID == "" {
-		boardID = a.Config.SelectedBoard
-	}
-	if boardID == "" {
-		return fmt.Errorf("no board specified: use --board-id or select a board with 'fizzy use'")
+	boardID, err := webhookBoardID(cmd, a)
+	if err != nil {
+		return err
 	}
 
 	payload := fizzy.UpdateWebhookPayload{}
@@ -47,22 +42,32 @@ func handleUpdateWebhook(cmd *cobra.Command, webhookID string) error {
 	}
 	if cmd.Flags().Changed("actions") {
 		actions, _ := cmd.Flags().GetStringSlice("actions")
+		if err := validateEachOneOf("actions", actions, webhookActions); err != nil {
+			return err
+		}
 		payload.SubscribedActions = actions
 	}
 
-	_, err := a.Client.UpdateWebhook(context.Background(), boardID, webhookID, payload)
+	var webhook *fizzy.Webhook
+	err = forWebhookBoard(cmd, a, boardID, app.MatchPrefix, func(id string) (err error) {
+		webhook, err = a.Client.UpdateWebhook(cmd.Context(), id, webhookID, payload)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("updating webhook: %w", err)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook '%s' updated successfully\n", webhookID)
-	return nil
+	return printResult(cmd, webhook, func() error {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook '%s' updated successfully\n", webhookID)
+		return nil
+	})
 }
 
 func init() {
-	webhookUpdateCmd.Flags().StringP("board-id", "b", "", "Board ID (uses selected board if not specified)")
+	webhookUpdateCmd.Flags().StringP("board-id", "b", "", "Board ID or name (uses selected board if not specified)")
 	webhookUpdateCmd.Flags().StringP("name", "n", "", "Webhook name")
-	webhookUpdateCmd.Flags().StringSliceP("actions", "a", nil, "Subscribed actions (comma-separated)")
+	webhookUpdateCmd.Flags().StringSliceP("actions", "a", nil, fmt.Sprintf("Subscribed actions (comma-separated). Available: %s", strings.Join(webhookActions, ", ")))
+	webhookUpdateCmd.RegisterFlagCompletionFunc("board-id", completeBoards)
 
 	webhookCmd.AddCommand(webhookUpdateCmd)
 }
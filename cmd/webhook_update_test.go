@@ -70,11 +70,11 @@ func TestWebhookUpdateCommandWithActions(t *testing.T) {
 		if len(webhookPayload.SubscribedActions) != 2 {
 			t.Errorf("expected 2 actions, got %d", len(webhookPayload.SubscribedActions))
 		}
-		if webhookPayload.SubscribedActions[0] != "card.created" {
-			t.Errorf("expected first action 'card.created', got %s", webhookPayload.SubscribedActions[0])
+		if webhookPayload.SubscribedActions[0] != "card_published" {
+			t.Errorf("expected first action 'card_published', got %s", webhookPayload.SubscribedActions[0])
 		}
-		if webhookPayload.SubscribedActions[1] != "card.closed" {
-			t.Errorf("expected second action 'card.closed', got %s", webhookPayload.SubscribedActions[1])
+		if webhookPayload.SubscribedActions[1] != "card_closed" {
+			t.Errorf("expected second action 'card_closed', got %s", webhookPayload.SubscribedActions[1])
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -90,7 +90,7 @@ func TestWebhookUpdateCommandWithActions(t *testing.T) {
 
 	cmd := webhookUpdateCmd
 	cmd.SetContext(testApp.ToContext(context.Background()))
-	cmd.ParseFlags([]string{"--board-id", "board-123", "--actions", "card.created,card.closed"})
+	cmd.ParseFlags([]string{"--board-id", "board-123", "--actions", "card_published,card_closed"})
 
 	if err := handleUpdateWebhook(cmd, "webhook-456"); err != nil {
 		t.Fatalf("handleUpdateWebhook failed: %v", err)
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/app"
@@ -13,25 +12,25 @@ var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Display current user identity and accounts",
 	Long:  `Show information about the currently authenticated user and their available accounts`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := handleWhoami(cmd); err != nil {
-			fmt.Fprintf(cmd.OutOrStderr(), "Error: %v\n", err)
-		}
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleWhoami(cmd)
 	},
 }
 
 func handleWhoami(cmd *cobra.Command) error {
 	a := app.FromContext(cmd.Context())
 	if a == nil || a.Client == nil {
-		return fmt.Errorf("API client not available")
+		return errNoClient
 	}
 
-	identity, err := a.Client.GetMyIdentity(context.Background())
+	identity, err := a.Client.GetMyIdentity(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("fetching identity: %w", err)
 	}
 
-	return ui.DisplayIdentity(cmd.OutOrStdout(), identity)
+	return printResult(cmd, identity, func() error {
+		return ui.DisplayIdentity(cmd.OutOrStdout(), identity)
+	})
 }
 
 func init() {
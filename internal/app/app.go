@@ -4,8 +4,11 @@ package app
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/rogeriopvl/fizzy-cli/internal/config"
@@ -15,33 +18,414 @@ import (
 type App struct {
 	Client *fizzy.Client
 	Config *config.Config
+
+	// BaseURL is the API the client talks to.
+	BaseURL string
+	// HTTPClient carries the debug, retry and timeout settings, for requests
+	// made without Client such as signing in.
+	HTTPClient *http.Client
+	// Credentials is where the access token is stored.
+	Credentials *config.CredentialStore
+	// Token is the access token in use, from FIZZY_ACCESS_TOKEN or the
+	// credential store.
+	Token string
+	// TokenFromEnv is set when Token comes from FIZZY_ACCESS_TOKEN.
+	TokenFromEnv bool
+	// Permission is the token's permission, read or write, when known.
+	Permission string
+	// AuthErr explains why no access token could be loaded, if that failed.
+	AuthErr error
+	// Local is the repository's config found from the working directory,
+	// if any.
+	Local *config.LocalConfig
+	// Account and Board, from --account/--board, FIZZY_ACCOUNT and
+	// FIZZY_BOARD or the local config, override the selection in the
+	// global config.
+	Account string
+	Board   string
+
+	// transport, timeout, cache and cacheMaxAge are what API clients are
+	// built with.
+	transport   http.RoundTripper
+	timeout     time.Duration
+	cache       bool
+	cacheMaxAge time.Duration
+}
+
+// DefaultTimeout is how long an API call may take, retries included.
+const DefaultTimeout = 30 * time.Second
+
+// CompletionMaxAge is how long shell completion reuses cached responses
+// without asking the API, and CompletionTimeout how long it waits for it.
+const (
+	CompletionMaxAge  = 5 * time.Minute
+	CompletionTimeout = 3 * time.Second
+)
+
+// Option overrides a setting from the config file, typically with the value
+// of a command line flag.
+type Option func(*options)
+
+type options struct {
+	maxRetries  *int
+	timeout     *time.Duration
+	noCache     bool
+	debug       bool
+	debugBodies bool
+	debugOutput io.Writer
+	passphrase  func() (string, error)
+	profile     string
+	baseURL     string
+	caCert      string
+	insecure    bool
+	account     string
+	board       string
+	completion  bool
+}
+
+// WithMaxRetries overrides the max_retries config key.
+func WithMaxRetries(n int) Option {
+	return func(o *options) {
+		o.maxRetries = &n
+	}
+}
+
+// WithTimeout sets how long an API call may take, retries included. Zero
+// means no limit.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = &d
+	}
+}
+
+// WithoutCache disables the HTTP response cache.
+func WithoutCache() Option {
+	return func(o *options) {
+		o.noCache = true
+	}
+}
+
+// WithDebug logs HTTP traffic, including bodies when bodies is set. The
+// FIZZY_DEBUG environment variable does the same: 1 logs requests and
+// responses, "body" adds their bodies.
+func WithDebug(bodies bool) Option {
+	return func(o *options) {
+		o.debug = true
+		o.debugBodies = o.debugBodies || bodies
+	}
+}
+
+// WithDebugOutput writes the debug log to w instead of stderr.
+func WithDebugOutput(w io.Writer) Option {
+	return func(o *options) {
+		o.debugOutput = w
+	}
+}
+
+// WithPassphrasePrompt asks for the credentials passphrase with prompt when
+// FIZZY_PASSPHRASE isn't set.
+func WithPassphrasePrompt(prompt func() (string, error)) Option {
+	return func(o *options) {
+		o.passphrase = prompt
+	}
+}
+
+// WithProfile uses the named profile from the config file, overriding
+// FIZZY_PROFILE and the current profile.
+func WithProfile(name string) Option {
+	return func(o *options) {
+		o.profile = name
+	}
+}
+
+// WithBaseURL talks to the Fizzy instance at baseURL, overriding
+// FIZZY_BASE_URL and the config.
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithCACert trusts the certificates in the PEM file path, overriding the
+// ca_cert config key.
+func WithCACert(path string) Option {
+	return func(o *options) {
+		o.caCert = path
+	}
+}
+
+// WithInsecureSkipVerify turns off TLS certificate verification.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) {
+		o.insecure = true
+	}
+}
+
+// WithAccount uses the account with slug, overriding FIZZY_ACCOUNT and the
+// selected account.
+func WithAccount(slug string) Option {
+	return func(o *options) {
+		o.account = slug
+	}
 }
 
-func New(version string) (*App, error) {
+// WithBoard uses the board with id, overriding FIZZY_BOARD and the selected
+// board.
+func WithBoard(id string) Option {
+	return func(o *options) {
+		o.board = id
+	}
+}
+
+// ForCompletion sets the app up for shell completion, which must be quick
+// and can't ask anything: cached responses are reused for CompletionMaxAge,
+// API calls aren't retried and time out after CompletionTimeout unless told
+// otherwise, and the credentials passphrase is never prompted for.
+func ForCompletion() Option {
+	return func(o *options) {
+		o.completion = true
+		o.passphrase = nil
+		if o.maxRetries == nil {
+			WithMaxRetries(0)(o)
+		}
+		if o.timeout == nil {
+			WithTimeout(CompletionTimeout)(o)
+		}
+	}
+}
+
+func New(version string, opts ...Option) (*App, error) {
+	var o options
+	switch os.Getenv("FIZZY_DEBUG") {
+	case "", "0", "false":
+	case "body":
+		WithDebug(true)(&o)
+	default:
+		WithDebug(false)(&o)
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, fmt.Errorf("loading config: %w", err)
 	}
 
-	token, isSet := os.LookupEnv("FIZZY_ACCESS_TOKEN")
-	if !isSet || token == "" {
-		return &App{Config: cfg}, nil // No token set, app will handle gracefully
+	local, err := findLocal()
+	if err != nil {
+		return nil, err
+	}
+
+	profile := o.profile
+	if profile == "" {
+		profile = os.Getenv("FIZZY_PROFILE")
+	}
+	if profile == "" {
+		profile = cfg.CurrentProfile
+	}
+	if profile != "" {
+		if err := cfg.UseProfile(profile); err != nil {
+			return nil, err
+		}
+	}
+
+	maxRetries := DefaultMaxRetries
+	if cfg.MaxRetries != nil {
+		maxRetries = *cfg.MaxRetries
+	}
+	if o.maxRetries != nil {
+		maxRetries = *o.maxRetries
+	}
+	baseURL := fizzy.DefaultBaseURL
+	for _, u := range []string{o.baseURL, os.Getenv("FIZZY_BASE_URL"), cfg.APIBaseURL()} {
+		if u != "" {
+			if baseURL, err = ParseBaseURL(u); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	caCert := cfg.CACert
+	if o.caCert != "" {
+		caCert = o.caCert
 	}
+	base, err := NewTransport(caCert, o.insecure || cfg.InsecureSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+	if o.debug {
+		out := o.debugOutput
+		if out == nil {
+			out = os.Stderr
+		}
+		// Innermost, so every retry and conditional request is logged.
+		base = NewDebugTransport(base, out, o.debugBodies)
+	}
+
+	retry := NewRetryTransport(base, maxRetries)
+	retry.AllMethods = cfg.RetryAllMethods
 
-	opts := []fizzy.ClientOption{
-		fizzy.WithHTTPClient(&http.Client{Timeout: 30 * time.Second}),
+	timeout := DefaultTimeout
+	if o.timeout != nil {
+		timeout = *o.timeout
 	}
 
-	if cfg.SelectedBoard != "" {
-		opts = append(opts, fizzy.WithBoard(cfg.SelectedBoard))
+	a := &App{
+		Config:     cfg,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: timeout, Transport: retry},
+		transport:  retry,
+		timeout:    timeout,
+		cache:      !o.noCache,
+		Local:      local,
 	}
+	if o.completion {
+		a.cacheMaxAge = CompletionMaxAge
+	}
+	var localAccount, localBoard string
+	if local != nil {
+		localAccount, localBoard = local.Account, local.Board
+	}
+	a.Account = AccountSlug(firstNonEmpty(o.account, os.Getenv("FIZZY_ACCOUNT"), localAccount))
+	a.Board = firstNonEmpty(o.board, os.Getenv("FIZZY_BOARD"), localBoard)
 
-	client, err := fizzy.NewClient(cfg.SelectedAccount, token, opts...)
+	a.Credentials = config.NewCredentialStore(cfg, HostOf(a.BaseURL))
+	if o.passphrase != nil && os.Getenv("FIZZY_PASSPHRASE") == "" {
+		a.Credentials.Passphrase = o.passphrase
+	}
+
+	token := os.Getenv("FIZZY_ACCESS_TOKEN")
+	a.TokenFromEnv = token != ""
+	if token == "" {
+		token, err = a.Credentials.Get(cfg.Credential())
+		if err != nil {
+			a.AuthErr = fmt.Errorf("loading access token: %w", err)
+			return a, nil
+		}
+		a.Permission = cfg.TokenPermission()
+	}
+	a.Token = token
+	account := a.SelectedAccount()
+	if token == "" || account == "" {
+		return a, nil // No token or account set, app will handle gracefully
+	}
+
+	client, err := a.NewClient(account, token)
 	if err != nil {
 		return nil, fmt.Errorf("creating API client: %w", err)
 	}
+	a.Client = client
+
+	return a, nil
+}
+
+// NewClient returns an API client for account authenticated with token,
+// sharing the app's HTTP settings and selected board.
+func (a *App) NewClient(account, token string) (*fizzy.Client, error) {
+	transport := a.transport
+	if a.cache {
+		// The cache is an optimisation: without a home directory requests
+		// simply go uncached.
+		if cache, err := NewCacheTransport(a.transport, account, token); err == nil {
+			cache.MaxAge = a.cacheMaxAge
+			transport = cache
+		}
+	}
+
+	if a.Permission == "read" {
+		transport = &ReadOnlyTransport{Base: transport}
+	}
+
+	clientOpts := []fizzy.ClientOption{
+		fizzy.WithHTTPClient(&http.Client{Timeout: a.timeout, Transport: transport}),
+		fizzy.WithBaseURL(a.BaseURL),
+	}
+
+	if board := a.SelectedBoard(); board != "" {
+		clientOpts = append(clientOpts, fizzy.WithBoard(board))
+	}
+
+	return fizzy.NewClient(account, token, clientOpts...)
+}
+
+// SelectedAccount returns the account to use: the override, if any, or the
+// one selected in the config.
+func (a *App) SelectedAccount() string {
+	if a.Account != "" {
+		return a.Account
+	}
+	if a.Config == nil {
+		return ""
+	}
+	return a.Config.SelectedAccount
+}
+
+// SelectedBoard returns the board to use: the override, if any, or the one
+// selected in the config. A board selected in the config belongs to the
+// config's account, so it's ignored when another account is used.
+func (a *App) SelectedBoard() string {
+	if a.Board != "" {
+		return a.Board
+	}
+	if a.Config == nil || a.otherAccount() {
+		return ""
+	}
+	return a.Config.SelectedBoard
+}
+
+// CurrentUserID returns the ID of the signed in user in the selected
+// account, when it's known.
+func (a *App) CurrentUserID() string {
+	if a.Config == nil || a.otherAccount() {
+		return ""
+	}
+	return a.Config.CurrentUserID
+}
 
-	return &App{Client: client, Config: cfg}, nil
+// otherAccount reports whether the account is overridden with one other
+// than the config's.
+func (a *App) otherAccount() bool {
+	return a.Account != "" && a.Config != nil && a.Account != a.Config.SelectedAccount
+}
+
+// findLocal returns the local config for the working directory, if any.
+func findLocal() (*config.LocalConfig, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		// Without a working directory there's no repository to look in.
+		return nil, nil
+	}
+	return config.FindLocal(dir)
+}
+
+// AccountSlug returns account as the API's account slugs are written, with a
+// leading slash, so that both 897362094 and /897362094 may be given.
+func AccountSlug(account string) string {
+	if account == "" {
+		return ""
+	}
+	return "/" + strings.TrimPrefix(account, "/")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// HostOf returns the host of baseURL, which identifies the API to
+// credential helpers.
+func HostOf(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return baseURL
+	}
+	return u.Host
 }
 
 // contextKey is a type for context keys to avoid collisions.
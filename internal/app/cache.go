@@ -0,0 +1,188 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const cacheDir = ".cache/fizzy-cli"
+
+// CacheDir returns the directory HTTP responses are cached in,
+// $HOME/.cache/fizzy-cli.
+func CacheDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	return filepath.Join(homeDir, cacheDir), nil
+}
+
+// ClearCache removes every cached response, for all accounts and tokens.
+func ClearCache() error {
+	dir, err := CacheDir()
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("removing cache directory: %w", err)
+	}
+	return nil
+}
+
+// CacheTransport caches GET responses that carry an ETag. Later requests
+// for the same URL are sent with If-None-Match, and a 304 Not Modified is
+// answered with the cached response, so unchanged resources cost a round
+// trip but no transfer.
+type CacheTransport struct {
+	Base http.RoundTripper
+	Dir  string
+	// MaxAge, when set, answers requests with responses cached less than
+	// MaxAge ago without asking the API, and caches responses without an
+	// ETag too. Shell completion uses it, where a slightly stale answer
+	// beats a slow one, or none when offline.
+	MaxAge time.Duration
+}
+
+// NewCacheTransport wraps base with a cache kept in a subdirectory of
+// CacheDir scoped to the account and token, so responses are never shared
+// between credentials that may see different data.
+func NewCacheTransport(base http.RoundTripper, account, token string) (*CacheTransport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	dir, err := AccountCacheDir(account, token)
+	if err != nil {
+		return nil, err
+	}
+	return &CacheTransport{Base: base, Dir: dir}, nil
+}
+
+// AccountCacheDir returns the subdirectory of the cache directory that
+// NewCacheTransport keeps account's responses for token in.
+func AccountCacheDir(account, token string) (string, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, hashKey(account+"\x00"+token)), nil
+}
+
+// cacheEntry is a cached response as stored on disk.
+type cacheEntry struct {
+	ETag     string      `json:"etag"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.Base.RoundTrip(req)
+	}
+
+	path := filepath.Join(t.Dir, hashKey(req.URL.String())+".json")
+	entry := readCacheEntry(path)
+	if entry != nil && t.MaxAge > 0 && time.Since(entry.StoredAt) < t.MaxAge {
+		return entry.response(req), nil
+	}
+	if entry != nil && entry.ETag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if t.MaxAge > 0 {
+			entry.StoredAt = time.Now()
+			writeCacheEntry(path, entry)
+		}
+		return entry.response(req), nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || (etag == "" && t.MaxAge == 0) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// A cache that can't be written only costs the next request a transfer.
+	writeCacheEntry(path, &cacheEntry{ETag: etag, Header: resp.Header, Body: body, StoredAt: time.Now()})
+
+	return resp, nil
+}
+
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+func readCacheEntry(path string) *cacheEntry {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil
+	}
+	return &entry
+}
+
+func writeCacheEntry(path string, entry *cacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	// Write to a temporary file first so concurrent invocations never read
+	// a partial entry.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".entry-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	os.Rename(tmp.Name(), path)
+}
+
+func hashKey(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
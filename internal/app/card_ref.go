@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// CardRef is a card as it's given on the command line.
+type CardRef struct {
+	Number int
+	// Account is the slug of the card's account, when it's given by URL.
+	Account string
+}
+
+// cardPath matches the path of a card's page, relative to the base URL.
+var cardPath = regexp.MustCompile(`^/([^/]+)/cards/(\d+)/?$`)
+
+// ParseCardRef parses a card number, optionally written #42, or the URL of
+// a card on the Fizzy instance at baseURL, e.g.
+// https://app.fizzy.do/897362094/cards/42.
+func ParseCardRef(ref, baseURL string) (CardRef, error) {
+	if !strings.Contains(ref, "://") {
+		n, err := strconv.Atoi(strings.TrimPrefix(ref, "#"))
+		if err != nil {
+			return CardRef{}, err
+		}
+		return CardRef{Number: n}, nil
+	}
+
+	if baseURL == "" {
+		baseURL = fizzy.DefaultBaseURL
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return CardRef{}, fmt.Errorf("parsing card URL: %w", err)
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return CardRef{}, fmt.Errorf("parsing base URL: %w", err)
+	}
+	if !strings.EqualFold(u.Host, base.Host) {
+		return CardRef{}, fmt.Errorf("card URL '%s' isn't on %s, use --base-url for other Fizzy instances", ref, base.Host)
+	}
+
+	path := strings.TrimPrefix(u.Path, strings.TrimRight(base.Path, "/"))
+	m := cardPath.FindStringSubmatch(path)
+	if m == nil {
+		return CardRef{}, fmt.Errorf("'%s' isn't a card URL, expected e.g. %s/897362094/cards/42", ref, baseURL)
+	}
+	n, err := strconv.Atoi(m[2])
+	if err != nil {
+		return CardRef{}, err
+	}
+	return CardRef{Number: n, Account: AccountSlug(m[1])}, nil
+}
+
+// SwitchAccount makes the app use account for the rest of the command,
+// replacing its client with one for that account.
+func (a *App) SwitchAccount(account string) error {
+	account = AccountSlug(account)
+	if account == a.SelectedAccount() {
+		return nil
+	}
+
+	// A board overridden for the previous account isn't in this one.
+	a.Account, a.Board = account, ""
+	client, err := a.NewClient(account, a.Token)
+	if err != nil {
+		return fmt.Errorf("switching to account %s: %w", account, err)
+	}
+	a.Client = client
+	return nil
+}
+
+// SwitchAccountForCard is SwitchAccount for the account of card number, as
+// given by its URL. The selected board belongs to the previous account, so
+// the card's own board is selected instead, for commands that need one.
+func (a *App) SwitchAccountForCard(ctx context.Context, account string, number int) error {
+	if AccountSlug(account) == a.SelectedAccount() {
+		return nil
+	}
+	if err := a.SwitchAccount(account); err != nil {
+		return err
+	}
+
+	card, err := a.Client.GetCard(ctx, number)
+	if err != nil {
+		return fmt.Errorf("fetching card #%d in account %s: %w", number, a.Account, err)
+	}
+	if card.Board.ID == "" {
+		return nil
+	}
+	a.Board = card.Board.ID
+	client, err := a.NewClient(a.Account, a.Token)
+	if err != nil {
+		return fmt.Errorf("switching to board %s: %w", card.Board.ID, err)
+	}
+	a.Client = client
+	return nil
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RedactToken shortens a secret to its first characters, enough to tell
+// tokens apart without revealing them.
+func RedactToken(token string) string {
+	const visible = 6
+	if len(token) <= visible {
+		return "..."
+	}
+	return token[:visible] + "..."
+}
+
+const redacted = "[REDACTED]"
+
+// redactHeader returns the value of header name safe to print: bearer
+// tokens are shortened and cookie values removed.
+func redactHeader(name, value string) string {
+	switch http.CanonicalHeaderKey(name) {
+	case "Authorization":
+		scheme, token, ok := strings.Cut(value, " ")
+		if !ok {
+			return redacted
+		}
+		return scheme + " " + RedactToken(token)
+	case "Cookie":
+		cookies := strings.Split(value, ";")
+		for i, c := range cookies {
+			cookies[i] = redactCookie(c)
+		}
+		return strings.Join(cookies, ";")
+	case "Set-Cookie":
+		// Only the first pair holds the cookie, the rest are attributes.
+		cookie, attrs, _ := strings.Cut(value, ";")
+		if attrs != "" {
+			return redactCookie(cookie) + ";" + attrs
+		}
+		return redactCookie(cookie)
+	}
+	return value
+}
+
+func redactCookie(cookie string) string {
+	name, _, ok := strings.Cut(cookie, "=")
+	if !ok {
+		return cookie
+	}
+	return name + "=" + redacted
+}
+
+// secretFieldPattern matches JSON string fields that carry credentials, such
+// as the token returned when creating an access token.
+var secretFieldPattern = regexp.MustCompile(`("(?:token|access_token|session_token|pending_authentication_token)"\s*:\s*)"[^"]*"`)
+
+func redactBody(body []byte) []byte {
+	return secretFieldPattern.ReplaceAll(body, []byte(`$1"`+redacted+`"`))
+}
+
+// DebugTransport logs every request and response to Out: method, URL,
+// headers, status and timing, and optionally the bodies. Credentials are
+// always redacted.
+type DebugTransport struct {
+	Base   http.RoundTripper
+	Out    io.Writer
+	Bodies bool
+}
+
+// NewDebugTransport wraps base, or http.DefaultTransport when base is nil.
+func NewDebugTransport(base http.RoundTripper, out io.Writer, bodies bool) *DebugTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &DebugTransport{Base: base, Out: out, Bodies: bodies}
+}
+
+func (t *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "--> %s %s\n", req.Method, req.URL)
+	writeHeaders(&buf, req.Header)
+	if t.Bodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			writeBody(&buf, data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.Base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		fmt.Fprintf(&buf, "<-- error: %v (%s)\n", err, elapsed)
+		t.Out.Write(buf.Bytes())
+		return nil, err
+	}
+
+	fmt.Fprintf(&buf, "<-- %s (%s)\n", resp.Status, elapsed)
+	writeHeaders(&buf, resp.Header)
+	if t.Bodies {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr != nil {
+			t.Out.Write(buf.Bytes())
+			return nil, readErr
+		}
+		writeBody(&buf, data)
+	}
+
+	// Written in one go so concurrent requests don't interleave.
+	t.Out.Write(buf.Bytes())
+	return resp, nil
+}
+
+func writeHeaders(w io.Writer, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(w, "    %s: %s\n", name, redactHeader(name, value))
+		}
+	}
+}
+
+func writeBody(w io.Writer, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n\n", bytes.TrimRight(redactBody(body), "\n"))
+}
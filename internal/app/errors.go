@@ -0,0 +1,48 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// APIError is an unsuccessful response from the Fizzy API.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
+
+var statusPattern = regexp.MustCompile(`(?s)unexpected status code (\d{3}): (.*)$`)
+
+// AsAPIError returns the API error err stands for, if any. The fizzy-go
+// client reports failed responses as formatted errors, so those are parsed
+// back into an APIError from the message.
+func AsAPIError(err error) (*APIError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	m := statusPattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return nil, false
+	}
+	code, _ := strconv.Atoi(m[1])
+	return &APIError{StatusCode: code, Body: m[2]}, true
+}
+
+// StatusCode returns the HTTP status of the API error err stands for, or 0
+// when err did not come from an API response.
+func StatusCode(err error) int {
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.StatusCode
+	}
+	return 0
+}
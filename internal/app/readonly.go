@@ -0,0 +1,52 @@
+package app
+
+import (
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ReadOnlyMessage explains why a read-only token's change was refused.
+const ReadOnlyMessage = "the access token in use is read-only; log in with a write token to make changes"
+
+// ReadOnlyTransport answers every request that could change data with 403
+// Forbidden instead of sending it, for read-only tokens. Commands known to
+// make changes fail before any request is made; this catches the rest,
+// however a change is made.
+type ReadOnlyTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if readOnlyAllowed(req) {
+		base := t.Base
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		return base.RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return &http.Response{
+		Status:        "403 Forbidden",
+		StatusCode:    http.StatusForbidden,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"text/plain; charset=utf-8"}},
+		Body:          io.NopCloser(strings.NewReader(ReadOnlyMessage)),
+		ContentLength: int64(len(ReadOnlyMessage)),
+		Request:       req,
+	}, nil
+}
+
+// readOnlyAllowed reports whether a read-only token may send req: reads,
+// and ending its own session, which changes nothing in an account.
+func readOnlyAllowed(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return req.Method == http.MethodDelete && strings.HasSuffix(req.URL.Path, "/session")
+}
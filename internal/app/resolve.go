@@ -0,0 +1,283 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// ResolveError is returned when a name doesn't identify exactly one
+// resource. Suggestions are the resources it might have meant.
+type ResolveError struct {
+	Kind        string
+	Query       string
+	Ambiguous   bool
+	Suggestions []string
+}
+
+func (e *ResolveError) Error() string {
+	msg := fmt.Sprintf("%s '%s' not found", e.Kind, e.Query)
+	if e.Ambiguous {
+		msg = fmt.Sprintf("%s '%s' matches more than one %s", e.Kind, e.Query, e.Kind)
+	}
+	if len(e.Suggestions) > 0 {
+		msg += fmt.Sprintf(", did you mean %s?", strings.Join(e.Suggestions, " or "))
+	}
+	return msg
+}
+
+// Match says how closely a name must match a resource to resolve to it.
+type Match int
+
+const (
+	// MatchPrefix accepts a resource's name, ignoring case, or the start of
+	// it when only one resource's name starts that way.
+	MatchPrefix Match = iota
+	// MatchExact accepts a resource's name only as is, for commands that
+	// change or delete what the name resolves to.
+	MatchExact
+)
+
+// idPattern matches what Fizzy IDs look like: lowercase letters and digits,
+// with at least one digit, maybe joined by dashes or underscores.
+var idPattern = regexp.MustCompile(`^[0-9a-z_-]*[0-9][0-9a-z_-]*$`)
+
+// LooksLikeID reports whether query could be an ID rather than a name.
+func LooksLikeID(query string) bool {
+	return idPattern.MatchString(query)
+}
+
+// maxSuggestions caps how many resources a ResolveError suggests.
+const maxSuggestions = 5
+
+// candidate is a resource a name may resolve to.
+type candidate struct {
+	id    string
+	names []string
+	label string
+}
+
+// resolve returns the index of the candidate query identifies: the one with
+// that ID, else the one with that name, else, with MatchPrefix, the one
+// with that name ignoring case or the only one whose name starts with it.
+func resolve(kind, query string, candidates []candidate, match Match) (int, error) {
+	for i, c := range candidates {
+		if c.id == query {
+			return i, nil
+		}
+	}
+
+	matchers := []func(name string) bool{
+		func(name string) bool { return name == query },
+	}
+	if match == MatchPrefix {
+		matchers = append(matchers,
+			func(name string) bool { return strings.EqualFold(name, query) },
+			func(name string) bool { return hasPrefixFold(name, query) },
+		)
+	}
+	for _, matches := range matchers {
+		var found []int
+		for i, c := range candidates {
+			for _, name := range c.names {
+				if matches(name) {
+					found = append(found, i)
+					break
+				}
+			}
+		}
+		switch {
+		case len(found) == 1:
+			return found[0], nil
+		case len(found) > 1:
+			return -1, &ResolveError{Kind: kind, Query: query, Ambiguous: true, Suggestions: labels(candidates, found)}
+		}
+	}
+
+	return -1, &ResolveError{Kind: kind, Query: query, Suggestions: labels(candidates, similar(query, candidates))}
+}
+
+// similar returns the candidates with a name that contains query, or is a
+// typo or two away from it when query is long enough for that to mean
+// something.
+func similar(query string, candidates []candidate) []int {
+	query = strings.ToLower(query)
+	var found []int
+	for i, c := range candidates {
+		for _, name := range c.names {
+			name = strings.ToLower(name)
+			if strings.Contains(name, query) || (len(query) > 3 && editDistance(name, query) <= 2) {
+				found = append(found, i)
+				break
+			}
+		}
+	}
+	return found
+}
+
+func labels(candidates []candidate, indexes []int) []string {
+	var out []string
+	for _, i := range indexes {
+		if len(out) == maxSuggestions {
+			break
+		}
+		out = append(out, fmt.Sprintf("%s (%s)", candidates[i].label, candidates[i].id))
+	}
+	return out
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+// ResolveBoard returns the board with ID or name query.
+func (a *App) ResolveBoard(ctx context.Context, query string, match Match) (*fizzy.Board, error) {
+	boards, err := a.Client.GetBoards(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching boards: %w", err)
+	}
+	i, err := resolve("board", query, boardCandidates(boards), match)
+	if err != nil {
+		return nil, err
+	}
+	return &boards[i], nil
+}
+
+// ResolveBoardIDs returns the IDs of the boards with IDs or names queries.
+func (a *App) ResolveBoardIDs(ctx context.Context, queries []string) ([]string, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	boards, err := a.Client.GetBoards(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching boards: %w", err)
+	}
+	return resolveIDs("board", queries, boardCandidates(boards))
+}
+
+// ResolveColumn returns the column of the selected board with ID or name
+// query.
+func (a *App) ResolveColumn(ctx context.Context, query string, match Match) (*fizzy.Column, error) {
+	columns, err := a.Client.GetColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching columns: %w", err)
+	}
+	candidates := make([]candidate, len(columns))
+	for i, c := range columns {
+		candidates[i] = candidate{id: c.ID, names: []string{c.Name}, label: c.Name}
+	}
+	i, err := resolve("column", query, candidates, match)
+	if err != nil {
+		return nil, err
+	}
+	return &columns[i], nil
+}
+
+// ResolveUser returns the user with ID, name or email address query.
+func (a *App) ResolveUser(ctx context.Context, query string, match Match) (*fizzy.User, error) {
+	users, err := a.Client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching users: %w", err)
+	}
+	i, err := resolve("user", query, userCandidates(users), match)
+	if err != nil {
+		return nil, err
+	}
+	return &users[i], nil
+}
+
+// ResolveUserIDs returns the IDs of the users with IDs, names or email
+// addresses queries.
+func (a *App) ResolveUserIDs(ctx context.Context, queries []string) ([]string, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	users, err := a.Client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching users: %w", err)
+	}
+	return resolveIDs("user", queries, userCandidates(users))
+}
+
+// ResolveTagIDs returns the IDs of the tags with IDs or titles queries,
+// which may start with #.
+func (a *App) ResolveTagIDs(ctx context.Context, queries []string) ([]string, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	tags, err := a.Client.GetTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching tags: %w", err)
+	}
+	candidates := make([]candidate, len(tags))
+	for i, t := range tags {
+		candidates[i] = candidate{id: t.ID, names: []string{t.Title}, label: "#" + t.Title}
+	}
+	trimmed := make([]string, len(queries))
+	for i, q := range queries {
+		trimmed[i] = strings.TrimPrefix(q, "#")
+	}
+	return resolveIDs("tag", trimmed, candidates)
+}
+
+// resolveIDs resolves each of queries. Lists may not include every
+// resource, so a query that looks like an ID and resembles none of them is
+// taken to be one.
+func resolveIDs(kind string, queries []string, candidates []candidate) ([]string, error) {
+	ids := make([]string, len(queries))
+	for i, q := range queries {
+		j, err := resolve(kind, q, candidates, MatchPrefix)
+		var resolveErr *ResolveError
+		switch {
+		case errors.As(err, &resolveErr) && !resolveErr.Ambiguous && len(resolveErr.Suggestions) == 0 && LooksLikeID(q):
+			ids[i] = q
+		case err != nil:
+			return nil, err
+		default:
+			ids[i] = candidates[j].id
+		}
+	}
+	return ids, nil
+}
+
+func boardCandidates(boards []fizzy.Board) []candidate {
+	candidates := make([]candidate, len(boards))
+	for i, b := range boards {
+		candidates[i] = candidate{id: b.ID, names: []string{b.Name}, label: b.Name}
+	}
+	return candidates
+}
+
+func userCandidates(users []fizzy.User) []candidate {
+	candidates := make([]candidate, len(users))
+	for i, u := range users {
+		candidates[i] = candidate{id: u.ID, names: []string{u.Name, u.Email}, label: u.Name}
+	}
+	return candidates
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 3
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// RetryTransport retries requests answered with 429 Too Many Requests or a
+// 5xx status, and requests that failed to get any response. It waits for
+// the Retry-After header when the API sends one, and backs off exponentially
+// with jitter otherwise.
+//
+// Only idempotent methods are retried unless AllMethods is set: a POST that
+// reached the server may have taken effect even though it failed.
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	AllMethods bool
+}
+
+// NewRetryTransport wraps base, or http.DefaultTransport when base is nil.
+func NewRetryTransport(base http.RoundTripper, maxRetries int) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{Base: base, MaxRetries: maxRetries}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.MaxRetries <= 0 || !t.canRetry(req) {
+		return t.Base.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.Base.RoundTrip(req)
+		if attempt >= t.MaxRetries || !shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay := backoff(attempt)
+		if resp != nil {
+			if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = d
+			}
+			// Drain the body so the connection can be reused.
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := wait(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RetryTransport) canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false // the body cannot be sent again
+	}
+	if t.AllMethods {
+		return true
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// wait sleeps for d unless ctx is done first.
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before retry attempt+1: exponential from
+// retryBaseDelay, capped at retryMaxDelay, with the upper half jittered so
+// concurrent clients spread out.
+func backoff(attempt int) time.Duration {
+	d := retryMaxDelay
+	if attempt < 16 {
+		d = min(retryBaseDelay<<attempt, retryMaxDelay)
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryAfter parses a Retry-After header, given either in seconds or as an
+// HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// AccessToken is a personal access token as listed by the API, which never
+// returns the token itself.
+type AccessToken struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Permission  string `json:"permission"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// ListAccessTokens returns the access tokens of the user c authenticates as.
+// Unlike creating them, which fizzy-go covers, listing and revoking tokens
+// isn't scoped to an account.
+func ListAccessTokens(ctx context.Context, c *fizzy.Client) ([]AccessToken, error) {
+	var tokens []AccessToken
+	next := c.BaseURL + "/my/access_tokens"
+	for next != "" {
+		var page []AccessToken
+		resp, err := doJSON(ctx, c.HTTPClient, http.MethodGet, next, bearer(c), nil, &page, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, page...)
+		next = nextLink(resp)
+	}
+	return tokens, nil
+}
+
+// DeleteAccessToken revokes the access token with the given ID.
+func DeleteAccessToken(ctx context.Context, c *fizzy.Client, id string) error {
+	endpointURL := c.BaseURL + "/my/access_tokens/" + url.PathEscape(id)
+	_, err := doJSON(ctx, c.HTTPClient, http.MethodDelete, endpointURL, bearer(c), nil, nil, http.StatusNoContent)
+	return err
+}
+
+func bearer(c *fizzy.Client) func(*http.Request) {
+	return func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+c.AccessToken)
+	}
+}
+
+var nextLinkPattern = regexp.MustCompile(`<([^>]+)>;\s*rel="?next"?`)
+
+// nextLink returns the URL of the next page from the Link header, or an
+// empty string on the last page.
+func nextLink(resp *http.Response) string {
+	m := nextLinkPattern.FindStringSubmatch(resp.Header.Get("Link"))
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
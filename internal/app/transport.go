@@ -0,0 +1,52 @@
+package app
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// NewTransport returns the transport requests are finally sent with: the
+// default one, which honours HTTPS_PROXY, HTTP_PROXY and NO_PROXY, trusting
+// the certificates in the PEM file caCert on top of the system's, and
+// skipping certificate verification altogether when insecure is set.
+func NewTransport(caCert string, insecure bool) (http.RoundTripper, error) {
+	if caCert == "" && !insecure {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+
+	if caCert != "" {
+		pem, err := os.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("reading CA certificate: no PEM certificates in %s", caCert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// ParseBaseURL validates the base URL of a Fizzy instance, such as
+// https://fizzy.example.com, and returns it without a trailing slash.
+func ParseBaseURL(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid base URL '%s', expected e.g. https://fizzy.example.com", baseURL)
+	}
+	return strings.TrimRight(baseURL, "/"), nil
+}
@@ -17,6 +17,124 @@ type Config struct {
 	SelectedAccount string `json:"selected_account"`
 	SelectedBoard   string `json:"selected_board"`
 	CurrentUserID   string `json:"current_user_id"`
+	// CredentialHelper is the external command access tokens are stored
+	// with instead of the credentials file; see CredentialStore.
+	CredentialHelper string `json:"credential_helper,omitempty"`
+
+	// BaseURL is the API of a self-hosted Fizzy instance; empty means
+	// app.fizzy.do.
+	BaseURL string `json:"base_url,omitempty"`
+	// CACert is a PEM file of certificates to trust on top of the system's,
+	// for instances behind a private CA.
+	CACert string `json:"ca_cert,omitempty"`
+	// InsecureSkipVerify turns off TLS certificate verification, for
+	// development instances only.
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty"`
+
+	// Timezone is the IANA name of the user's timezone, set with fizzy
+	// timezone set. Timestamps are shown in it.
+	Timezone string `json:"timezone,omitempty"`
+
+	// MaxRetries is how many times failed requests are retried; unset means
+	// the default, 0 disables retries.
+	MaxRetries *int `json:"max_retries,omitempty"`
+	// RetryAllMethods also retries non-idempotent requests such as POST.
+	RetryAllMethods bool `json:"retry_all_methods,omitempty"`
+
+	// TokenPermissions records the permission, read or write, of stored
+	// tokens by credential name, where it's known.
+	TokenPermissions map[string]string `json:"token_permissions,omitempty"`
+
+	// Profiles are named alternatives to the selection above, for working
+	// across several accounts.
+	Profiles map[string]*Profile `json:"profiles,omitempty"`
+	// CurrentProfile is the profile used when neither --profile nor
+	// FIZZY_PROFILE is given; empty uses the selection above.
+	CurrentProfile string `json:"current_profile,omitempty"`
+
+	// profile is the profile applied with UseProfile, which Save writes the
+	// selection back to, leaving the top-level selection in defaults.
+	profile  string
+	defaults Profile
+}
+
+// Profile is a named account, board and token.
+type Profile struct {
+	Account string `json:"account,omitempty"`
+	Board   string `json:"board,omitempty"`
+	UserID  string `json:"user_id,omitempty"`
+	// BaseURL is the API the profile talks to; empty means the default.
+	BaseURL string `json:"base_url,omitempty"`
+	// Credential is the name the profile's token is stored under; empty
+	// means the profile's name.
+	Credential string `json:"credential,omitempty"`
+}
+
+// UseProfile makes the named profile's account, board and user the
+// selected ones. Changes to the selection are saved to the profile.
+func (c *Config) UseProfile(name string) error {
+	p, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile '%s' not found", name)
+	}
+	if c.profile == "" {
+		c.defaults = Profile{Account: c.SelectedAccount, Board: c.SelectedBoard, UserID: c.CurrentUserID}
+	}
+	c.profile = name
+	c.SelectedAccount = p.Account
+	c.SelectedBoard = p.Board
+	c.CurrentUserID = p.UserID
+	return nil
+}
+
+// Profile returns the name of the profile in use, or an empty string.
+func (c *Config) Profile() string {
+	return c.profile
+}
+
+// Credential returns the name the token in use is stored under.
+func (c *Config) Credential() string {
+	if c.profile == "" {
+		return DefaultCredential
+	}
+	return c.Profiles[c.profile].CredentialName(c.profile)
+}
+
+// TokenPermission returns the permission of the stored token in use, or an
+// empty string if it isn't known.
+func (c *Config) TokenPermission() string {
+	return c.TokenPermissions[c.Credential()]
+}
+
+// SetTokenPermission records the permission of the stored token in use; an
+// empty permission forgets it.
+func (c *Config) SetTokenPermission(permission string) {
+	if permission == "" {
+		delete(c.TokenPermissions, c.Credential())
+		return
+	}
+	if c.TokenPermissions == nil {
+		c.TokenPermissions = map[string]string{}
+	}
+	c.TokenPermissions[c.Credential()] = permission
+}
+
+// APIBaseURL returns the API to talk to: the profile in use's, else the
+// base_url key, or an empty string for the default.
+func (c *Config) APIBaseURL() string {
+	if c.profile != "" && c.Profiles[c.profile].BaseURL != "" {
+		return c.Profiles[c.profile].BaseURL
+	}
+	return c.BaseURL
+}
+
+// CredentialName returns the name the token of the profile called name is
+// stored under.
+func (p *Profile) CredentialName(name string) string {
+	if p.Credential != "" {
+		return p.Credential
+	}
+	return name
 }
 
 // Load reads the config file from $HOME/.config/fizzy-cli/config.json.
@@ -57,7 +175,19 @@ func (c *Config) Save() error {
 
 	configPath := filepath.Join(configDirPath, configFile)
 
-	data, err := json.MarshalIndent(c, "", "  ")
+	saved := *c
+	if c.profile != "" {
+		if p, ok := c.Profiles[c.profile]; ok {
+			p.Account = c.SelectedAccount
+			p.Board = c.SelectedBoard
+			p.UserID = c.CurrentUserID
+		}
+		saved.SelectedAccount = c.defaults.Account
+		saved.SelectedBoard = c.defaults.Board
+		saved.CurrentUserID = c.defaults.UserID
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshaling config: %w", err)
 	}
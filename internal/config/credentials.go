@@ -0,0 +1,342 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const credentialsFile = "credentials.json"
+
+// DefaultCredential is the name the access token is stored under.
+const DefaultCredential = "default"
+
+// ErrPassphraseRequired is returned when a token is encrypted and no
+// passphrase is available to decrypt it.
+var ErrPassphraseRequired = errors.New("credentials are encrypted: set FIZZY_PASSPHRASE or run the command in a terminal to enter the passphrase")
+
+// CredentialStore keeps access tokens out of config.json and the shell
+// environment. Tokens live in $HOME/.config/fizzy-cli/credentials.json,
+// readable only by the user and optionally encrypted with a passphrase, or
+// in an external credential helper.
+//
+// Helpers follow git's credential helper protocol: the helper command is run
+// with "get", "store" or "erase" as its last argument and key=value lines on
+// stdin (protocol, host, username and, for store, password). For get, it
+// prints the token as a password= line. A helper "foo" runs the command
+// fizzy-credential-foo, a path runs as is, and a value starting with "!"
+// runs as a shell command.
+type CredentialStore struct {
+	// Helper is the credential helper command; empty uses the credentials
+	// file.
+	Helper string
+	// Host identifies the API to the helper.
+	Host string
+	// Passphrase returns the passphrase that encrypted tokens are read and,
+	// with Encrypt, written with.
+	Passphrase func() (string, error)
+	// Encrypt stores tokens encrypted in the credentials file.
+	Encrypt bool
+}
+
+// NewCredentialStore returns the store configured in cfg for the API at
+// host. The passphrase comes from FIZZY_PASSPHRASE, which also turns on
+// encryption when tokens are stored.
+func NewCredentialStore(cfg *Config, host string) *CredentialStore {
+	passphrase := os.Getenv("FIZZY_PASSPHRASE")
+	return &CredentialStore{
+		Helper: cfg.CredentialHelper,
+		Host:   host,
+		Passphrase: func() (string, error) {
+			if passphrase == "" {
+				return "", ErrPassphraseRequired
+			}
+			return passphrase, nil
+		},
+		Encrypt: passphrase != "",
+	}
+}
+
+// Location describes where tokens are stored, for messages.
+func (s *CredentialStore) Location() string {
+	if s.Helper != "" {
+		return fmt.Sprintf("credential helper '%s'", s.Helper)
+	}
+	path, err := credentialsPath()
+	if err != nil {
+		return credentialsFile
+	}
+	return path
+}
+
+// Get returns the token stored under name, or an empty string if there is
+// none.
+func (s *CredentialStore) Get(name string) (string, error) {
+	if s.Helper != "" {
+		out, err := s.runHelper("get", name, "")
+		if err != nil {
+			return "", err
+		}
+		return parseHelperOutput(out)["password"], nil
+	}
+
+	creds, err := loadCredentials()
+	if err != nil {
+		return "", err
+	}
+	entry, ok := creds.Credentials[name]
+	if !ok {
+		return "", nil
+	}
+	if entry.Encrypted == "" {
+		return entry.Token, nil
+	}
+
+	passphrase, err := s.passphrase()
+	if err != nil {
+		return "", err
+	}
+	return decryptToken(entry.Encrypted, passphrase)
+}
+
+// Store saves token under name, encrypted with Encrypt or when the token it
+// replaces was, so replacing a token never drops its encryption.
+func (s *CredentialStore) Store(name, token string) error {
+	if s.Helper != "" {
+		_, err := s.runHelper("store", name, token)
+		return err
+	}
+
+	creds, err := loadCredentials()
+	if err != nil {
+		return err
+	}
+
+	entry := credential{Token: token}
+	if s.Encrypt || creds.Credentials[name].Encrypted != "" {
+		passphrase, err := s.passphrase()
+		if err != nil {
+			return err
+		}
+		encrypted, err := encryptToken(token, passphrase)
+		if err != nil {
+			return err
+		}
+		entry = credential{Encrypted: encrypted}
+	}
+
+	creds.Credentials[name] = entry
+	return creds.save()
+}
+
+// Erase removes the token stored under name. Erasing a missing token is not
+// an error.
+func (s *CredentialStore) Erase(name string) error {
+	if s.Helper != "" {
+		_, err := s.runHelper("erase", name, "")
+		return err
+	}
+
+	creds, err := loadCredentials()
+	if err != nil {
+		return err
+	}
+	if _, ok := creds.Credentials[name]; !ok {
+		return nil
+	}
+	delete(creds.Credentials, name)
+	return creds.save()
+}
+
+func (s *CredentialStore) passphrase() (string, error) {
+	if s.Passphrase == nil {
+		return "", ErrPassphraseRequired
+	}
+	return s.Passphrase()
+}
+
+// credentials is the content of the credentials file.
+type credentials struct {
+	Credentials map[string]credential `json:"credentials"`
+}
+
+// credential is a stored token, in the clear or encrypted.
+type credential struct {
+	Token     string `json:"token,omitempty"`
+	Encrypted string `json:"encrypted,omitempty"`
+}
+
+func credentialsPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	return filepath.Join(homeDir, configDir, credentialsFile), nil
+}
+
+func loadCredentials() (*credentials, error) {
+	creds := &credentials{Credentials: map[string]credential{}}
+
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return creds, nil
+		}
+		return nil, fmt.Errorf("reading credentials file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials file: %w", err)
+	}
+	if creds.Credentials == nil {
+		creds.Credentials = map[string]credential{}
+	}
+	return creds, nil
+}
+
+func (c *credentials) save() error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling credentials: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("writing credentials file: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file; make sure it's private.
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("writing credentials file: %w", err)
+	}
+	return nil
+}
+
+const (
+	saltSize         = 16
+	keySize          = 32
+	pbkdf2Iterations = 600_000
+)
+
+// encryptToken encrypts token with AES-256-GCM under a key derived from
+// passphrase, returning salt, nonce and ciphertext base64 encoded together.
+func encryptToken(token, passphrase string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("encrypting token: %w", err)
+	}
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", fmt.Errorf("encrypting token: %w", err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("encrypting token: %w", err)
+	}
+
+	sealed := gcm.Seal(nil, nonce, []byte(token), nil)
+	data := append(append(salt, nonce...), sealed...)
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func decryptToken(encrypted, passphrase string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(data) < saltSize {
+		return "", fmt.Errorf("decrypting token: malformed credentials")
+	}
+	salt, data := data[:saltSize], data[saltSize:]
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", fmt.Errorf("decrypting token: %w", err)
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", fmt.Errorf("decrypting token: malformed credentials")
+	}
+	nonce, sealed := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	token, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypting token: wrong passphrase")
+	}
+	return string(token), nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, pbkdf2Iterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// runHelper runs the credential helper for action and returns its output.
+func (s *CredentialStore) runHelper(action, name, token string) (string, error) {
+	command := strings.TrimSpace(s.Helper)
+	switch {
+	case command == "":
+		return "", fmt.Errorf("empty credential helper")
+	case strings.HasPrefix(command, "!"):
+		command = strings.TrimPrefix(command, "!")
+	case !strings.ContainsRune(strings.Fields(command)[0], filepath.Separator):
+		command = "fizzy-credential-" + command
+	}
+
+	var input bytes.Buffer
+	fmt.Fprintf(&input, "protocol=https\nhost=%s\nusername=%s\n", s.Host, name)
+	if token != "" {
+		fmt.Fprintf(&input, "password=%s\n", token)
+	}
+	input.WriteString("\n")
+
+	cmd := exec.Command("sh", "-c", command+" "+action)
+	cmd.Stdin = &input
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running credential helper '%s %s': %w", s.Helper, action, err)
+	}
+	return string(out), nil
+}
+
+func parseHelperOutput(out string) map[string]string {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		if key, value, ok := strings.Cut(line, "="); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
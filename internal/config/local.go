@@ -0,0 +1,85 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// LocalFiles are the names of a repository's own config, looked for in the
+// working directory and its parents, in order of preference.
+var LocalFiles = []string{".fizzy.json", filepath.Join(".fizzy", "config")}
+
+// LocalConfig is a repository's own selection, layered over the global
+// config so that everyone working in it uses the same board.
+type LocalConfig struct {
+	Account string `json:"account,omitempty"`
+	Board   string `json:"board,omitempty"`
+	// Tags are the titles of the tags new cards get when none are given.
+	Tags []string `json:"tags,omitempty"`
+	// Assignee is the ID of the user new cards are assigned to, or "me".
+	Assignee string `json:"assignee,omitempty"`
+
+	path string
+}
+
+// FindLocal looks for a local config in dir and its parents, returning nil
+// when there is none.
+func FindLocal(dir string) (*LocalConfig, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolving directory: %w", err)
+	}
+
+	for {
+		for _, name := range LocalFiles {
+			path := filepath.Join(dir, name)
+			data, err := os.ReadFile(path)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("reading local config: %w", err)
+			}
+
+			local := &LocalConfig{path: path}
+			if err := json.Unmarshal(data, local); err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", path, err)
+			}
+			return local, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// NewLocal returns an empty local config to be saved as .fizzy.json in dir.
+func NewLocal(dir string) *LocalConfig {
+	return &LocalConfig{path: filepath.Join(dir, LocalFiles[0])}
+}
+
+// Path returns the file the local config is read from and saved to.
+func (l *LocalConfig) Path() string {
+	return l.path
+}
+
+// Save writes the local config back to its file. Unlike the global config
+// it's meant to be committed, so it's readable by everyone.
+func (l *LocalConfig) Save() error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling local config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return fmt.Errorf("creating local config directory: %w", err)
+	}
+	if err := os.WriteFile(l.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing local config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// AuthStatus describes the access token in use and what it gives access to.
+type AuthStatus struct {
+	Token           string          `json:"token"`
+	Source          string          `json:"source"`
+	Profile         string          `json:"profile,omitempty"`
+	Permission      string          `json:"permission"`
+	User            fizzy.User      `json:"user"`
+	Accounts        []fizzy.Account `json:"accounts"`
+	SelectedAccount string          `json:"selected_account"`
+	SelectedBoard   string          `json:"selected_board"`
+	BoardName       string          `json:"board_name,omitempty"`
+}
+
+func DisplayAuthStatus(w io.Writer, status *AuthStatus) error {
+	fmt.Fprintf(w, "✓ Logged in as %s (%s)\n", status.User.Name, status.User.Email)
+	fmt.Fprintf(w, "Token: %s %s\n", status.Token, DisplayMeta("from", status.Source))
+	fmt.Fprintf(w, "Permission: %s\n", status.Permission)
+	if status.Profile != "" {
+		fmt.Fprintf(w, "Profile: %s\n", status.Profile)
+	}
+
+	fmt.Fprintf(w, "\nAccounts:\n")
+	for _, account := range status.Accounts {
+		marker := "  "
+		if account.Slug == status.SelectedAccount {
+			marker = "* "
+		}
+		fmt.Fprintf(w, "%s%s %s\n", marker, account.Name, DisplayMeta("slug", account.Slug))
+	}
+
+	fmt.Fprintf(w, "\n")
+	if status.SelectedAccount == "" {
+		fmt.Fprintf(w, "Selected account: none\n")
+	} else {
+		fmt.Fprintf(w, "Selected account: %s\n", status.SelectedAccount)
+	}
+	switch {
+	case status.SelectedBoard == "":
+		fmt.Fprintf(w, "Selected board: none\n")
+	case status.BoardName != "":
+		fmt.Fprintf(w, "Selected board: %s (%s)\n", status.BoardName, DisplayID(status.SelectedBoard))
+	default:
+		fmt.Fprintf(w, "Selected board: %s\n", DisplayID(status.SelectedBoard))
+	}
+	return nil
+}
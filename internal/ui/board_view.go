@@ -0,0 +1,559 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// LaneKind tells the board view's lanes apart: the board's columns, and the
+// lanes for cards awaiting triage, postponed and closed.
+type LaneKind int
+
+const (
+	LaneMaybe LaneKind = iota
+	LaneColumn
+	LaneNotNow
+	LaneDone
+)
+
+// Lane is a column of the board view.
+type Lane struct {
+	Kind LaneKind
+	Name string
+	// Column is the board column the lane shows, for LaneColumn.
+	Column *fizzy.Column
+	Color  lipgloss.Color
+	Cards  []fizzy.Card
+}
+
+// BoardSource is where the board view gets its lanes, and what it changes
+// cards with.
+type BoardSource interface {
+	Lanes(ctx context.Context) ([]Lane, error)
+	Card(ctx context.Context, number int) (*fizzy.Card, error)
+	Move(ctx context.Context, card fizzy.Card, to Lane) error
+	Users(ctx context.Context) ([]fizzy.User, error)
+	Assign(ctx context.Context, card fizzy.Card, userID string) error
+	Tag(ctx context.Context, card fizzy.Card, title string) error
+}
+
+// minLaneWidth is how narrow a lane may get before lanes scroll sideways.
+const minLaneWidth = 24
+
+var (
+	boardTitleStyle    = lipgloss.NewStyle().Bold(true)
+	boardSelectedStyle = lipgloss.NewStyle().Reverse(true)
+	boardDimStyle      = lipgloss.NewStyle().Faint(true)
+	boardErrorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type boardMode int
+
+const (
+	boardBrowsing boardMode = iota
+	boardAssigning
+	boardTagging
+	boardDetails
+)
+
+// Messages the board view's commands report back with.
+type (
+	lanesMsg struct {
+		lanes []Lane
+		err   error
+	}
+	actionMsg struct {
+		status string
+		err    error
+		// follow is the number of a card to select once reloaded, e.g. one
+		// that was moved to another lane.
+		follow int
+	}
+	usersMsg struct {
+		card  fizzy.Card
+		users []fizzy.User
+		err   error
+	}
+	cardMsg struct {
+		card *fizzy.Card
+		err  error
+	}
+	refreshMsg struct{}
+)
+
+type boardModel struct {
+	ctx      context.Context
+	source   BoardSource
+	title    string
+	refresh  time.Duration
+	readOnly bool
+
+	lanes  []Lane
+	lane   int
+	cards  []int // the selected card's index in each lane
+	follow int
+
+	mode    boardMode
+	picker  pickerModel
+	users   []fizzy.User
+	target  fizzy.Card
+	input   string
+	details string
+
+	status  string
+	err     error
+	loading bool
+	width   int
+	height  int
+}
+
+func newBoardModel(ctx context.Context, title string, source BoardSource, refresh time.Duration, readOnly bool) boardModel {
+	return boardModel{ctx: ctx, source: source, title: title, refresh: refresh, readOnly: readOnly, loading: true}
+}
+
+// boardChangeKeys are the keys that change cards, which do nothing with a
+// read-only token.
+var boardChangeKeys = map[string]bool{
+	"shift+left": true, "H": true, "shift+right": true, "L": true,
+	"c": true, "p": true, "a": true, "t": true,
+}
+
+func (m boardModel) Init() tea.Cmd {
+	return m.load()
+}
+
+func (m boardModel) load() tea.Cmd {
+	return func() tea.Msg {
+		lanes, err := m.source.Lanes(m.ctx)
+		return lanesMsg{lanes, err}
+	}
+}
+
+// tick schedules the next background refresh.
+func (m boardModel) tick() tea.Cmd {
+	if m.refresh <= 0 {
+		return nil
+	}
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg { return refreshMsg{} })
+}
+
+// do runs action on the selected card in the background, reporting status
+// when it succeeds.
+func (m boardModel) do(status string, follow int, action func(card fizzy.Card) error) tea.Cmd {
+	card, ok := m.selected()
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		if err := action(card); err != nil {
+			return actionMsg{err: err}
+		}
+		return actionMsg{status: status, follow: follow}
+	}
+}
+
+func (m boardModel) selected() (fizzy.Card, bool) {
+	if m.lane >= len(m.lanes) || len(m.lanes[m.lane].Cards) == 0 {
+		return fizzy.Card{}, false
+	}
+	return m.lanes[m.lane].Cards[m.cards[m.lane]], true
+}
+
+func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case lanesMsg:
+		first := m.lanes == nil
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			if first {
+				// There's no board to show; ViewBoard returns the error.
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		m.setLanes(msg.lanes)
+		if first {
+			return m, m.tick()
+		}
+		return m, nil
+
+	case refreshMsg:
+		return m, tea.Batch(m.load(), m.tick())
+
+	case actionMsg:
+		m.err = msg.err
+		if msg.err == nil {
+			m.status = msg.status
+			m.follow = msg.follow
+		}
+		m.loading = true
+		return m, m.load()
+
+	case usersMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		items := make([]PickerItem, len(msg.users))
+		for i, u := range msg.users {
+			items[i] = PickerItem{Label: u.Name, Detail: u.Email}
+		}
+		m.mode, m.users, m.target = boardAssigning, msg.users, msg.card
+		m.picker = newPickerModel(fmt.Sprintf("Toggle assignees of #%d:", msg.card.Number), items, true)
+		m.picker.exit = nil
+		return m, nil
+
+	case cardMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.mode, m.details = boardDetails, renderCard(msg.card)
+		return m, nil
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		switch m.mode {
+		case boardAssigning:
+			return m.updateAssigning(msg)
+		case boardTagging:
+			return m.updateTagging(msg)
+		case boardDetails:
+			if msg.Type == tea.KeyEsc || msg.Type == tea.KeyEnter || msg.String() == "q" {
+				m.mode = boardBrowsing
+			}
+			return m, nil
+		}
+		return m.updateBrowsing(msg)
+	}
+	return m, nil
+}
+
+func (m boardModel) updateBrowsing(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.status, m.err = "", nil
+	if m.readOnly && boardChangeKeys[key.String()] {
+		m.status = "The access token in use is read-only: log in with a write token to change cards"
+		return m, nil
+	}
+	switch key.String() {
+	case "q", "esc":
+		return m, tea.Quit
+	case "left", "h":
+		if m.lane > 0 {
+			m.lane--
+		}
+	case "right", "l":
+		if m.lane < len(m.lanes)-1 {
+			m.lane++
+		}
+	case "up", "k":
+		if len(m.lanes) > 0 && m.cards[m.lane] > 0 {
+			m.cards[m.lane]--
+		}
+	case "down", "j":
+		if len(m.lanes) > 0 && m.cards[m.lane] < len(m.lanes[m.lane].Cards)-1 {
+			m.cards[m.lane]++
+		}
+	case "shift+left", "H":
+		return m, m.moveTo(m.lane-1, true)
+	case "shift+right", "L":
+		return m, m.moveTo(m.lane+1, true)
+	case "c":
+		return m, m.moveTo(m.laneOf(LaneDone), false)
+	case "p":
+		return m, m.moveTo(m.laneOf(LaneNotNow), false)
+	case "a":
+		card, ok := m.selected()
+		if !ok {
+			return m, nil
+		}
+		return m, func() tea.Msg {
+			users, err := m.source.Users(m.ctx)
+			return usersMsg{card, users, err}
+		}
+	case "t":
+		if card, ok := m.selected(); ok {
+			m.mode, m.target, m.input = boardTagging, card, ""
+		}
+	case "enter":
+		card, ok := m.selected()
+		if !ok {
+			return m, nil
+		}
+		return m, func() tea.Msg {
+			details, err := m.source.Card(m.ctx, card.Number)
+			return cardMsg{details, err}
+		}
+	case "r":
+		m.loading = true
+		return m, m.load()
+	}
+	return m, nil
+}
+
+// moveTo moves the selected card to the lane at index to, and the
+// selection with it when follow is set.
+func (m boardModel) moveTo(to int, follow bool) tea.Cmd {
+	if to < 0 || to >= len(m.lanes) || to == m.lane {
+		return nil
+	}
+	lane := m.lanes[to]
+	card, ok := m.selected()
+	if !ok {
+		return nil
+	}
+	status := fmt.Sprintf("✓ Card #%d moved to %s", card.Number, lane.Name)
+	var followed int
+	if follow {
+		followed = card.Number
+	}
+	return m.do(status, followed, func(card fizzy.Card) error {
+		return m.source.Move(m.ctx, card, lane)
+	})
+}
+
+func (m boardModel) laneOf(kind LaneKind) int {
+	for i, lane := range m.lanes {
+		if lane.Kind == kind {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m boardModel) updateAssigning(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker, _ := m.picker.Update(key)
+	m.picker = picker.(pickerModel)
+	if !m.picker.done {
+		return m, nil
+	}
+	m.mode = boardBrowsing
+	if m.picker.cancelled {
+		return m, nil
+	}
+
+	var users []fizzy.User
+	for _, i := range m.picker.chosen() {
+		users = append(users, m.users[i])
+	}
+	card := m.target
+	return m, func() tea.Msg {
+		var names []string
+		for _, u := range users {
+			if err := m.source.Assign(m.ctx, card, u.ID); err != nil {
+				return actionMsg{err: err}
+			}
+			names = append(names, u.Name)
+		}
+		return actionMsg{status: fmt.Sprintf("✓ Card #%d assignment toggled for %s", card.Number, strings.Join(names, ", "))}
+	}
+}
+
+func (m boardModel) updateTagging(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch key.Type {
+	case tea.KeyEsc:
+		m.mode = boardBrowsing
+	case tea.KeyEnter:
+		m.mode = boardBrowsing
+		title := strings.TrimPrefix(strings.TrimSpace(m.input), "#")
+		if title == "" {
+			return m, nil
+		}
+		card := m.target
+		return m, func() tea.Msg {
+			if err := m.source.Tag(m.ctx, card, title); err != nil {
+				return actionMsg{err: err}
+			}
+			return actionMsg{status: fmt.Sprintf("✓ Tag #%s toggled on card #%d", title, card.Number)}
+		}
+	case tea.KeyBackspace:
+		if in := []rune(m.input); len(in) > 0 {
+			m.input = string(in[:len(in)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.input += string(key.Runes)
+	}
+	return m, nil
+}
+
+// setLanes replaces the lanes with freshly loaded ones, keeping the same
+// card selected, or the card being followed.
+func (m *boardModel) setLanes(lanes []Lane) {
+	var selected int
+	if card, ok := m.selected(); ok {
+		selected = card.Number
+	}
+	if m.follow != 0 {
+		selected = m.follow
+		m.follow = 0
+	}
+
+	// Each lane keeps its selected card, wherever it now is in the lane,
+	// or the same position when the card has left it.
+	type position struct{ number, index int }
+	previous := map[laneKey]position{}
+	for i, lane := range m.lanes {
+		if len(lane.Cards) > 0 {
+			previous[keyOf(lane)] = position{lane.Cards[m.cards[i]].Number, m.cards[i]}
+		}
+	}
+
+	m.lanes = lanes
+	m.cards = make([]int, len(lanes))
+	m.lane = min(m.lane, max(0, len(lanes)-1))
+	for i, lane := range lanes {
+		if p, ok := previous[keyOf(lane)]; ok {
+			m.cards[i] = min(p.index, max(0, len(lane.Cards)-1))
+			for j, card := range lane.Cards {
+				if card.Number == p.number {
+					m.cards[i] = j
+				}
+			}
+		}
+		for j, card := range lane.Cards {
+			if card.Number == selected {
+				m.lane, m.cards[i] = i, j
+			}
+		}
+	}
+}
+
+// laneKey tells lanes apart across refreshes.
+type laneKey struct {
+	kind   LaneKind
+	column string
+}
+
+func keyOf(lane Lane) laneKey {
+	key := laneKey{kind: lane.Kind}
+	if lane.Column != nil {
+		key.column = lane.Column.ID
+	}
+	return key
+}
+
+func (m boardModel) View() string {
+	switch m.mode {
+	case boardAssigning:
+		return m.picker.View()
+	case boardDetails:
+		return m.details + "\n" + boardDimStyle.Render("Esc to go back")
+	}
+
+	var b strings.Builder
+	b.WriteString(boardTitleStyle.Render(m.title))
+	if m.readOnly {
+		b.WriteString(boardDimStyle.Render("  read-only"))
+	}
+	if m.loading {
+		b.WriteString(boardDimStyle.Render("  refreshing…"))
+	}
+	b.WriteString("\n\n")
+
+	if len(m.lanes) > 0 {
+		b.WriteString(m.viewLanes())
+		b.WriteString("\n")
+	}
+
+	switch {
+	case m.mode == boardTagging:
+		fmt.Fprintf(&b, "Tag to toggle on #%d: #%s█", m.target.Number, m.input)
+	case m.err != nil:
+		b.WriteString(boardErrorStyle.Render("Error: " + m.err.Error()))
+	default:
+		b.WriteString(m.status)
+	}
+	help := "←/→ lane  ↑/↓ card  H/L move  c close  p postpone  a assign  t tag  enter details  r refresh  q quit"
+	if m.readOnly {
+		help = "←/→ lane  ↑/↓ card  enter details  r refresh  q quit"
+	}
+	b.WriteString("\n" + boardDimStyle.Render(help))
+	return b.String()
+}
+
+func (m boardModel) viewLanes() string {
+	width := m.width
+	if width == 0 {
+		width = 80
+	}
+	laneWidth := max(minLaneWidth, width/len(m.lanes))
+	visible := max(1, min(len(m.lanes), width/laneWidth))
+	first := min(max(0, m.lane-visible+1), len(m.lanes)-visible)
+
+	// The title, status and help take four lines, the lane's header two.
+	rows := 20
+	if m.height > 0 {
+		rows = max(1, m.height-6)
+	}
+
+	views := make([]string, 0, visible)
+	for i := first; i < first+visible; i++ {
+		views = append(views, m.viewLane(i, laneWidth-1, rows))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, views...)
+}
+
+func (m boardModel) viewLane(i, width, rows int) string {
+	lane := m.lanes[i]
+	header := lipgloss.NewStyle().Bold(true).Foreground(lane.Color)
+	if i == m.lane {
+		header = header.Underline(true)
+	}
+
+	lines := []string{
+		header.Render(runewidth.Truncate(fmt.Sprintf("%s (%d)", lane.Name, len(lane.Cards)), width, "…")),
+		boardDimStyle.Render(strings.Repeat("─", width)),
+	}
+
+	start := max(0, m.cards[i]-rows+1)
+	end := min(len(lane.Cards), start+rows)
+	bar := lipgloss.NewStyle().Foreground(lane.Color).Render("▌")
+	for j, card := range lane.Cards[start:end] {
+		title := card.Title
+		if card.Golden {
+			title = "★ " + title
+		}
+		text := runewidth.FillRight(runewidth.Truncate(fmt.Sprintf("#%d %s", card.Number, title), width-2, "…"), width-2)
+		if i == m.lane && start+j == m.cards[i] {
+			text = boardSelectedStyle.Render(text)
+		}
+		lines = append(lines, bar+" "+text)
+	}
+	if len(lane.Cards) == 0 {
+		lines = append(lines, boardDimStyle.Render("  No cards"))
+	}
+	return lipgloss.NewStyle().Width(width + 1).Render(strings.Join(lines, "\n"))
+}
+
+// ViewBoard shows the board source lays out as a kanban board on the
+// terminal until the user quits, refreshing it every refresh unless that's
+// zero. With readOnly, the keys that change cards are turned off.
+func ViewBoard(ctx context.Context, title string, source BoardSource, refresh time.Duration, readOnly bool) error {
+	if !IsTerminal() {
+		return ErrNotTerminal
+	}
+	m := newBoardModel(ctx, title, source, refresh, readOnly)
+	final, err := tea.NewProgram(m, tea.WithAltScreen(), tea.WithOutput(os.Stderr), tea.WithContext(ctx)).Run()
+	if err != nil {
+		return err
+	}
+	if m := final.(boardModel); m.err != nil && m.lanes == nil {
+		return m.err
+	}
+	return nil
+}
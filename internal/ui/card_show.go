@@ -2,36 +2,44 @@ package ui
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	fizzy "github.com/rogeriopvl/fizzy-go"
 )
 
 func DisplayCard(card *fizzy.Card) error {
+	fmt.Print(renderCard(card))
+	return nil
+}
+
+// renderCard returns the card's details as DisplayCard prints them.
+func renderCard(card *fizzy.Card) string {
+	var b strings.Builder
 	boldStyle := lipgloss.NewStyle().Bold(true)
 	dimStyle := lipgloss.NewStyle().Bold(true).Faint(true)
-	fmt.Printf("%s\n", boldStyle.Render(fmt.Sprintf("%s (#%d)", card.Title, card.Number)))
-	fmt.Println("─────────────────────────────────────")
-	fmt.Printf("%s %s\n", dimStyle.Render("Description:"), card.Description)
-	fmt.Printf("%s %v\n", dimStyle.Render("Tags:"), card.Tags)
-	fmt.Printf("%s %v\n", dimStyle.Render("Golden:"), card.Golden)
-	fmt.Printf("%s %v\n", dimStyle.Render("Closed:"), card.Closed)
+	fmt.Fprintf(&b, "%s\n", boldStyle.Render(fmt.Sprintf("%s (#%d)", card.Title, card.Number)))
+	fmt.Fprintln(&b, "─────────────────────────────────────")
+	fmt.Fprintf(&b, "%s %s\n", dimStyle.Render("Description:"), card.Description)
+	fmt.Fprintf(&b, "%s %v\n", dimStyle.Render("Tags:"), card.Tags)
+	fmt.Fprintf(&b, "%s %v\n", dimStyle.Render("Golden:"), card.Golden)
+	fmt.Fprintf(&b, "%s %v\n", dimStyle.Render("Closed:"), card.Closed)
 	if card.Column != nil {
-		fmt.Printf("%s %s\n", dimStyle.Render("Column:"), card.Column.Name)
+		fmt.Fprintf(&b, "%s %s\n", dimStyle.Render("Column:"), card.Column.Name)
 	}
-	fmt.Printf("%s %s\n", dimStyle.Render("Status:"), card.Status)
-	fmt.Printf("%s %s\n", dimStyle.Render("Created:"), card.CreatedAt)
-	fmt.Printf("%s %s\n", dimStyle.Render("Last Active:"), card.LastActiveAt)
-	fmt.Printf("%s %s\n", dimStyle.Render("URL:"), card.URL)
+	fmt.Fprintf(&b, "%s %s\n", dimStyle.Render("Status:"), card.Status)
+	fmt.Fprintf(&b, "%s %s\n", dimStyle.Render("Created:"), card.CreatedAt)
+	fmt.Fprintf(&b, "%s %s\n", dimStyle.Render("Last Active:"), card.LastActiveAt)
+	fmt.Fprintf(&b, "%s %s\n", dimStyle.Render("URL:"), card.URL)
 	if len(card.Steps) > 0 {
-		fmt.Printf("%s\n", dimStyle.Render("Steps:"))
+		fmt.Fprintf(&b, "%s\n", dimStyle.Render("Steps:"))
 		for _, step := range card.Steps {
 			checkmark := "☐"
 			if step.Completed {
 				checkmark = "☑"
 			}
-			fmt.Printf("  %s %s\n", checkmark, step.Content)
+			fmt.Fprintf(&b, "  %s %s\n", checkmark, step.Content)
 		}
 	}
-	return nil
+	return b.String()
 }
@@ -10,19 +10,19 @@ import (
 
 func DisplayColumns(columns []fizzy.Column) error {
 	for _, column := range columns {
-		colorName := column.Color.Name
-		colorDef := colors.ByName(colorName)
-
-		termColor := lipgloss.Color("7") // default to white
-		if colorDef != nil {
-			termColor = colorDef.TermColor
-		}
-
 		styledName := lipgloss.NewStyle().
-			Foreground(termColor).
+			Foreground(ColumnColor(column)).
 			Render(column.Name)
 
 		fmt.Printf("%s (%s)\n", styledName, DisplayID(column.ID))
 	}
 	return nil
 }
+
+// ColumnColor returns the terminal colour of the column's colour.
+func ColumnColor(column fizzy.Column) lipgloss.Color {
+	if colorDef := colors.ByName(column.Color.Name); colorDef != nil {
+		return colorDef.TermColor
+	}
+	return lipgloss.Color("7") // default to white
+}
@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Table is a list of records reduced to a set of fields, ready to be
+// rendered as rows.
+type Table struct {
+	Fields  []string
+	Records []Record
+}
+
+// Record holds the selected field values of a single item, keyed by field
+// path. It encodes as an object whose keys follow the field order.
+type Record struct {
+	fields []string
+	values map[string]any
+}
+
+// Strings returns the record's values flattened with FlattenValue, in field
+// order.
+func (r Record) Strings() []string {
+	row := make([]string, len(r.fields))
+	for i, f := range r.fields {
+		row[i] = FlattenValue(r.values[f])
+	}
+	return row
+}
+
+func (r Record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range r.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(r.values[f])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// SelectFields reduces items, a slice of API structs, to the given fields.
+// Fields are JSON field names and may reach into nested objects with dots,
+// e.g. "column.name". Unknown fields are rejected with the list of fields
+// the item type has.
+func SelectFields(items any, fields []string) (*Table, error) {
+	rv := reflect.ValueOf(items)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("cannot select fields from %T", items)
+	}
+
+	known := FieldPaths(rv.Type().Elem())
+	for _, f := range fields {
+		if !slices.Contains(known, f) {
+			return nil, fmt.Errorf("unknown field '%s'. Available fields: %s", f, strings.Join(known, ", "))
+		}
+	}
+
+	table := &Table{Fields: fields, Records: make([]Record, 0, rv.Len())}
+	for i := 0; i < rv.Len(); i++ {
+		data, err := json.Marshal(rv.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("encoding item: %w", err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		var obj map[string]any
+		if err := dec.Decode(&obj); err != nil {
+			return nil, fmt.Errorf("decoding item: %w", err)
+		}
+
+		record := Record{fields: fields, values: make(map[string]any, len(fields))}
+		for _, f := range fields {
+			record.values[f] = lookupPath(obj, strings.Split(f, "."))
+		}
+		table.Records = append(table.Records, record)
+	}
+
+	return table, nil
+}
+
+// FieldPaths lists the dotted JSON field paths available on t, sorted.
+func FieldPaths(t reflect.Type) []string {
+	var paths []string
+	collectFieldPaths(t, "", &paths, map[reflect.Type]bool{})
+	sort.Strings(paths)
+	return paths
+}
+
+func collectFieldPaths(t reflect.Type, prefix string, paths *[]string, seen map[reflect.Type]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if sf.Anonymous && name == "" {
+			collectFieldPaths(sf.Type, prefix, paths, seen)
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		path := prefix + name
+		*paths = append(*paths, path)
+		collectFieldPaths(sf.Type, path+".", paths, seen)
+	}
+}
+
+func lookupPath(obj map[string]any, path []string) any {
+	var v any = obj
+	for _, key := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+// FlattenValue renders a field value as a single string. Scalars print as
+// is and null prints as an empty string. Lists are flattened element by
+// element and joined with ", ". Objects flatten to their name, falling back
+// to their title and then their id, so "column" and "column.name" print the
+// same thing.
+func FlattenValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = FlattenValue(item)
+		}
+		return strings.Join(parts, ", ")
+	case map[string]any:
+		for _, key := range []string{"name", "title", "id"} {
+			if s, ok := v[key]; ok {
+				return FlattenValue(s)
+			}
+		}
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// DisplayTable writes the table as aligned text columns under a header of
+// upper-cased field names.
+func DisplayTable(w io.Writer, table *Table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	header := make([]string, len(table.Fields))
+	for i, f := range table.Fields {
+		header[i] = strings.ToUpper(f)
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	// Tabs and newlines inside values would break the column layout.
+	clean := strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+	for _, record := range table.Records {
+		row := record.Strings()
+		for i := range row {
+			row[i] = clean.Replace(row[i])
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
@@ -16,6 +16,15 @@ func DisplayID(id string) string {
 	return DisplayMeta("id", id)
 }
 
+// timezone is the location FormatTime renders timestamps in; nil keeps
+// the timestamp's own offset.
+var timezone *time.Location
+
+// SetTimezone makes FormatTime render timestamps in loc.
+func SetTimezone(loc *time.Location) {
+	timezone = loc
+}
+
 // FormatTime converts an RFC3339 timestamp string to a human-readable format.
 // If parsing fails, returns the original string.
 func FormatTime(timeStr string) string {
@@ -23,5 +32,8 @@ func FormatTime(timeStr string) string {
 	if err != nil {
 		return timeStr
 	}
+	if timezone != nil {
+		t = t.In(timezone)
+	}
 	return t.Format("2006-01-02 15:04:05")
 }
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an output format selectable with the global --output flag.
+type Format string
+
+const (
+	FormatText     Format = "text"
+	FormatJSON     Format = "json"
+	FormatYAML     Format = "yaml"
+	FormatCSV      Format = "csv"
+	FormatTSV      Format = "tsv"
+	FormatMarkdown Format = "markdown"
+)
+
+var formats = []Format{FormatText, FormatJSON, FormatYAML, FormatCSV, FormatTSV, FormatMarkdown}
+
+// IsTabular reports whether the format renders rows of fields, which only
+// list commands can produce.
+func (f Format) IsTabular() bool {
+	return f == FormatCSV || f == FormatTSV || f == FormatMarkdown
+}
+
+// ParseFormat validates an --output value. An empty value means text.
+func ParseFormat(s string) (Format, error) {
+	if s == "" {
+		return FormatText, nil
+	}
+	for _, f := range formats {
+		if string(f) == strings.ToLower(s) {
+			return f, nil
+		}
+	}
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		names[i] = string(f)
+	}
+	return "", fmt.Errorf("invalid output format '%s'. Available formats: %s", s, strings.Join(names, ", "))
+}
+
+// Encode writes v to w in a machine-readable format. Values are encoded
+// through their JSON tags in both formats, so YAML keys match the API's
+// field names.
+func Encode(w io.Writer, format Format, v any) error {
+	v = emptyIfNil(v)
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case FormatYAML:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("encoding output: %w", err)
+		}
+		// YAML is a superset of JSON, so decoding into a node keeps the
+		// JSON field order; only the flow and quoting styles need resetting.
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return fmt.Errorf("encoding output: %w", err)
+		}
+		resetStyle(&node)
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return fmt.Errorf("encoding output: %w", err)
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("format '%s' cannot encode values", format)
+	}
+}
+
+// emptyIfNil turns a nil slice into an empty one so lists encode as []
+// rather than null.
+func emptyIfNil(v any) any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		return reflect.MakeSlice(rv.Type(), 0, 0).Interface()
+	}
+	return v
+}
+
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
@@ -0,0 +1,258 @@
+// Package ui
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+)
+
+// ErrPickCancelled is returned when the picker is closed without choosing.
+var ErrPickCancelled = errors.New("cancelled")
+
+// PickerItem is one of the choices Pick and PickMany offer.
+type PickerItem struct {
+	// Label is what's shown and filtered on.
+	Label string
+	// Detail, e.g. an ID or email address, is shown dimmed after the label
+	// and filtered on too.
+	Detail string
+	// Preview is shown under the list while the item is highlighted.
+	Preview string
+}
+
+// pickerRows is how many items are shown at once.
+const pickerRows = 10
+
+var (
+	pickerCursorStyle = lipgloss.NewStyle().Bold(true)
+	pickerDimStyle    = lipgloss.NewStyle().Faint(true)
+)
+
+type pickerModel struct {
+	title string
+	items []PickerItem
+	multi bool
+
+	query string
+	// matches are the indexes of the items matching query, best first.
+	matches  []int
+	cursor   int
+	selected map[int]bool
+
+	done, cancelled bool
+	// exit is run once the picker is done: tea.Quit, unless it's part of a
+	// bigger program.
+	exit tea.Cmd
+}
+
+func newPickerModel(title string, items []PickerItem, multi bool) pickerModel {
+	m := pickerModel{title: title, items: items, multi: multi, selected: map[int]bool{}, exit: tea.Quit}
+	m.filter()
+	return m
+}
+
+func (m pickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch key.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		m.done, m.cancelled = true, true
+		return m, m.exit
+	case tea.KeyEnter:
+		if len(m.chosen()) > 0 {
+			m.done = true
+			return m, m.exit
+		}
+	case tea.KeyUp, tea.KeyCtrlP:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case tea.KeyDown, tea.KeyCtrlN:
+		if m.cursor < len(m.matches)-1 {
+			m.cursor++
+		}
+	case tea.KeyTab:
+		if m.multi && len(m.matches) > 0 {
+			i := m.matches[m.cursor]
+			m.selected[i] = !m.selected[i]
+			if m.cursor < len(m.matches)-1 {
+				m.cursor++
+			}
+		}
+	case tea.KeyBackspace:
+		if q := []rune(m.query); len(q) > 0 {
+			m.query = string(q[:len(q)-1])
+			m.filter()
+		}
+	case tea.KeyCtrlU:
+		m.query = ""
+		m.filter()
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(key.Runes)
+		m.filter()
+	}
+	return m, nil
+}
+
+// filter matches the items against the query and moves the cursor back to
+// the best match.
+func (m *pickerModel) filter() {
+	type match struct{ index, score int }
+	var found []match
+	for i, item := range m.items {
+		if score, ok := fuzzyScore(m.query, item.Label+" "+item.Detail); ok {
+			found = append(found, match{i, score})
+		}
+	}
+	slices.SortStableFunc(found, func(a, b match) int { return b.score - a.score })
+
+	m.matches = m.matches[:0]
+	for _, f := range found {
+		m.matches = append(m.matches, f.index)
+	}
+	m.cursor = 0
+}
+
+// chosen returns the indexes of the chosen items: the selected ones, in the
+// order they're listed, or else the highlighted one.
+func (m pickerModel) chosen() []int {
+	var chosen []int
+	for i := range m.items {
+		if m.selected[i] {
+			chosen = append(chosen, i)
+		}
+	}
+	if len(chosen) == 0 && len(m.matches) > 0 {
+		chosen = []int{m.matches[m.cursor]}
+	}
+	return chosen
+}
+
+func (m pickerModel) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n> %s█\n\n", m.title, m.query)
+
+	start := max(0, m.cursor-pickerRows+1)
+	end := min(len(m.matches), start+pickerRows)
+	for row, i := range m.matches[start:end] {
+		item := m.items[i]
+		cursor := "  "
+		label := item.Label
+		if start+row == m.cursor {
+			cursor = "> "
+			label = pickerCursorStyle.Render(label)
+		}
+		if m.multi {
+			box := "[ ] "
+			if m.selected[i] {
+				box = "[x] "
+			}
+			cursor += box
+		}
+		b.WriteString(cursor + label)
+		if item.Detail != "" {
+			b.WriteString("  " + pickerDimStyle.Render(item.Detail))
+		}
+		b.WriteString("\n")
+	}
+	if len(m.matches) == 0 {
+		b.WriteString(pickerDimStyle.Render("  No matches") + "\n")
+	} else if hidden := len(m.matches) - end; hidden > 0 {
+		b.WriteString(pickerDimStyle.Render(fmt.Sprintf("  … %d more", hidden)) + "\n")
+	}
+
+	if len(m.matches) > 0 {
+		if preview := m.items[m.matches[m.cursor]].Preview; preview != "" {
+			b.WriteString("\n" + preview + "\n")
+		}
+	}
+
+	help := "Type to filter, ↑/↓ to move, Enter to choose, Esc to cancel"
+	if m.multi {
+		help = "Type to filter, ↑/↓ to move, Tab to select, Enter to choose, Esc to cancel"
+	}
+	b.WriteString("\n" + pickerDimStyle.Render(help))
+	return b.String()
+}
+
+// fuzzyScore reports whether the letters of query appear in text in order,
+// ignoring case, and scores the match higher the more of them are adjacent
+// or start a word.
+func fuzzyScore(query, text string) (int, bool) {
+	q := []rune(strings.ToLower(query))
+	t := []rune(strings.ToLower(text))
+
+	score, qi, prev := 0, 0, -2
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		score++
+		if ti == prev+1 {
+			score += 5
+		}
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]) {
+			score += 3
+		}
+		prev = ti
+		qi++
+	}
+	return score, qi == len(q)
+}
+
+// Pick lets the user choose one of items on the terminal, filtering them by
+// typing, and returns its index. It returns ErrNotTerminal when there's no
+// terminal to ask on and ErrPickCancelled when the user gives up.
+func Pick(title string, items []PickerItem) (int, error) {
+	chosen, err := runPicker(title, items, false)
+	if err != nil {
+		return -1, err
+	}
+	return chosen[0], nil
+}
+
+// PickMany is Pick for choosing any number of items, selected with Tab. It
+// returns their indexes in the order of items.
+func PickMany(title string, items []PickerItem) ([]int, error) {
+	return runPicker(title, items, true)
+}
+
+// IsTerminal reports whether Pick and PickMany can ask on a terminal.
+func IsTerminal() bool {
+	return term.IsTerminal(os.Stdin.Fd()) && term.IsTerminal(os.Stderr.Fd())
+}
+
+func runPicker(title string, items []PickerItem, multi bool) ([]int, error) {
+	if !IsTerminal() {
+		return nil, ErrNotTerminal
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("nothing to choose from")
+	}
+
+	// Drawn on stderr, so the command's output can still be piped.
+	final, err := tea.NewProgram(newPickerModel(title, items, multi), tea.WithOutput(os.Stderr)).Run()
+	if err != nil {
+		return nil, err
+	}
+	m := final.(pickerModel)
+	if m.cancelled {
+		return nil, ErrPickCancelled
+	}
+	return m.chosen(), nil
+}
@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+)
+
+// ProfileSummary is a profile from the config file, as listed.
+type ProfileSummary struct {
+	Name       string `json:"name"`
+	Account    string `json:"account"`
+	Board      string `json:"board"`
+	BaseURL    string `json:"base_url"`
+	Credential string `json:"credential"`
+	Current    bool   `json:"current"`
+}
+
+func DisplayProfiles(w io.Writer, profiles []ProfileSummary) error {
+	for _, p := range profiles {
+		marker := "  "
+		if p.Current {
+			marker = "* "
+		}
+		line := marker + p.Name
+		if p.Account != "" {
+			line += " " + DisplayMeta("account", p.Account)
+		}
+		if p.Board != "" {
+			line += " " + DisplayMeta("board", p.Board)
+		}
+		if p.BaseURL != "" {
+			line += " " + DisplayMeta("base url", p.BaseURL)
+		}
+		fmt.Fprintln(w, line)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// ErrNotTerminal is returned when a prompt needs a terminal to read from.
+var ErrNotTerminal = errors.New("not a terminal")
+
+// PromptPassword prints prompt to stderr and reads a line from the terminal
+// without echoing it.
+func PromptPassword(prompt string) (string, error) {
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return "", ErrNotTerminal
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	password, err := term.ReadPassword(os.Stdin.Fd())
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("reading password: %w", err)
+	}
+	return string(password), nil
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// defaultFields are the columns tabular formats print for each list item
+// type when --fields is not given.
+var defaultFields = map[reflect.Type][]string{
+	reflect.TypeFor[fizzy.Account]():         {"name", "slug", "id"},
+	reflect.TypeFor[fizzy.Activity]():        {"created_at", "action", "description", "creator", "board"},
+	reflect.TypeFor[fizzy.Board]():           {"id", "name", "all_access", "creator", "created_at"},
+	reflect.TypeFor[fizzy.BoardAccess]():     {"id", "name", "email_address", "has_access", "involvement"},
+	reflect.TypeFor[fizzy.Card]():            {"number", "title", "status", "column", "tags", "creator", "closed", "golden", "last_active_at"},
+	reflect.TypeFor[fizzy.Column]():          {"id", "name", "color", "created_at"},
+	reflect.TypeFor[fizzy.Comment]():         {"id", "creator", "body.plain_text", "created_at"},
+	reflect.TypeFor[fizzy.Notification]():    {"id", "read", "title", "card", "creator", "created_at"},
+	reflect.TypeFor[fizzy.Reaction]():        {"id", "content", "reacter"},
+	reflect.TypeFor[fizzy.Tag]():             {"id", "title", "created_at"},
+	reflect.TypeFor[fizzy.User]():            {"id", "name", "email_address", "role", "active"},
+	reflect.TypeFor[fizzy.Webhook]():         {"id", "name", "payload_url", "active", "subscribed_actions"},
+	reflect.TypeFor[fizzy.WebhookDelivery](): {"id", "created_at", "state", "event.action", "response.code"},
+}
+
+// DefaultFields returns the columns printed for items, a slice of API
+// structs, when no fields were selected.
+func DefaultFields(items any) []string {
+	t := reflect.TypeOf(items)
+	if t == nil || t.Kind() != reflect.Slice {
+		return nil
+	}
+	if fields, ok := defaultFields[t.Elem()]; ok {
+		return fields
+	}
+	return FieldPaths(t.Elem())
+}
+
+// WriteTable writes the table in one of the tabular formats. Values are
+// flattened with FlattenValue in every format.
+func WriteTable(w io.Writer, format Format, table *Table) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, table)
+	case FormatTSV:
+		return writeTSV(w, table)
+	case FormatMarkdown:
+		return writeMarkdown(w, table)
+	default:
+		return fmt.Errorf("format '%s' cannot render tables", format)
+	}
+}
+
+func writeCSV(w io.Writer, table *Table) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(table.Fields); err != nil {
+		return err
+	}
+	for _, record := range table.Records {
+		if err := cw.Write(record.Strings()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// tsvEscapes keeps every record on a single line with one tab per column.
+var tsvEscapes = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+
+func writeTSV(w io.Writer, table *Table) error {
+	fmt.Fprintln(w, strings.Join(table.Fields, "\t"))
+	for _, record := range table.Records {
+		row := record.Strings()
+		for i := range row {
+			row[i] = tsvEscapes.Replace(row[i])
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return nil
+}
+
+var markdownEscapes = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func writeMarkdown(w io.Writer, table *Table) error {
+	rows := make([][]string, 0, len(table.Records)+1)
+	rows = append(rows, table.Fields)
+	for _, record := range table.Records {
+		row := record.Strings()
+		for i := range row {
+			row[i] = markdownEscapes.Replace(row[i])
+		}
+		rows = append(rows, row)
+	}
+
+	// Pad by display width so columns line up with wide characters and
+	// emoji too; the separator row needs at least three dashes.
+	widths := make([]int, len(table.Fields))
+	for i := range widths {
+		widths[i] = 3
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], runewidth.StringWidth(cell))
+		}
+	}
+
+	writeRow := func(row []string) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = runewidth.FillRight(cell, widths[i])
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+	}
+
+	writeRow(rows[0])
+	separator := make([]string, len(widths))
+	for i, width := range widths {
+		separator[i] = strings.Repeat("-", width)
+	}
+	writeRow(separator)
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// templateEscapes expands the escape sequences people type in a shell-quoted
+// --template value, such as '{{.Number}}\t{{.Title}}'.
+var templateEscapes = strings.NewReplacer(`\t`, "\t", `\n`, "\n", `\\`, `\`)
+
+var templateFuncs = template.FuncMap{
+	"join": func(sep string, items []string) string {
+		return strings.Join(items, sep)
+	},
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"time": FormatTime,
+}
+
+// DisplayTemplate executes a Go text/template once per item of items, a
+// slice of API structs, writing a newline after each. Fields are accessed by
+// their Go names, e.g. {{.Number}} or {{.Creator.Name}}.
+func DisplayTemplate(w io.Writer, text string, items any) error {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(templateEscapes.Replace(text))
+	if err != nil {
+		return fmt.Errorf("parsing template: %w", err)
+	}
+
+	rv := reflect.ValueOf(items)
+	if rv.Kind() != reflect.Slice {
+		return fmt.Errorf("cannot apply a template to %T", items)
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if err := tmpl.Execute(w, rv.Index(i).Interface()); err != nil {
+			return fmt.Errorf("executing template: %w", err)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/rogeriopvl/fizzy-cli/internal/app"
+)
+
+func DisplayAccessTokens(w io.Writer, tokens []app.AccessToken) error {
+	for _, token := range tokens {
+		fmt.Fprintf(w, "%s [%s] created %s (%s)\n", token.Description, token.Permission, FormatTime(token.CreatedAt), DisplayID(token.ID))
+	}
+	return nil
+}